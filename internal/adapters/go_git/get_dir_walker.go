@@ -46,14 +46,12 @@ func (g *GoGit) GetDirWalker(workingDir, gitRef, path string) (core.DirWalker, e
 	relSlash := filepath.ToSlash(rel)
 	gitPath := pathpkg.Join(relSlash, filepath.ToSlash(path))
 
-	refName := plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", gitRef))
-
-	refAgainst, err := repository.Reference(refName, false)
+	hashAgainst, err := resolveRevision(repository, gitRef)
 	if err != nil {
-		return nil, &core.GitRefNotFoundError{GitRef: gitRef}
+		return nil, err
 	}
 
-	commitAgainst, err := repository.CommitObject(refAgainst.Hash())
+	commitAgainst, err := repository.CommitObject(hashAgainst)
 	if err != nil {
 		return nil, fmt.Errorf("repository.CommitObject: %w", err)
 	}
@@ -66,3 +64,21 @@ func (g *GoGit) GetDirWalker(workingDir, gitRef, path string) (core.DirWalker, e
 	gitTreeWalker := go_git.NewGitTreeWalker(treeAgainst, relSlash, gitPath)
 	return gitTreeWalker, nil
 }
+
+// resolveRevision resolves local branch first for backward compatibility,
+// then any git revision: remote branch (origin/main), tag, commit hash, HEAD~1, etc.
+func resolveRevision(repository *gogit.Repository, gitRef string) (plumbing.Hash, error) {
+	refName := plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", gitRef))
+
+	refAgainst, err := repository.Reference(refName, false)
+	if err == nil {
+		return refAgainst.Hash(), nil
+	}
+
+	hash, err := repository.ResolveRevision(plumbing.Revision(gitRef))
+	if err != nil {
+		return plumbing.ZeroHash, &core.GitRefNotFoundError{GitRef: gitRef}
+	}
+
+	return *hash, nil
+}
@@ -0,0 +1,101 @@
+package go_git
+
+import (
+	"errors"
+	"fmt"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"golang.org/x/mod/semver"
+
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+func (g *GoGit) LastVersionTag(workingDir string) (string, error) {
+	repository, err := gogit.PlainOpenWithOptions(workingDir, &gogit.PlainOpenOptions{
+		DetectDotGit: true,
+	})
+	if err != nil {
+		if errors.Is(err, gogit.ErrRepositoryNotExists) {
+			return "", core.ErrRepositoryDoesNotExist
+		}
+
+		return "", fmt.Errorf("git.PlainOpenWithOptions: %w", err)
+	}
+
+	tags, err := versionTags(repository)
+	if err != nil {
+		return "", fmt.Errorf("versionTags: %w", err)
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		return "", fmt.Errorf("repository.Head: %w", err)
+	}
+
+	commits, err := repository.Log(&gogit.LogOptions{From: head.Hash(), Order: gogit.LogOrderCommitterTime})
+	if err != nil {
+		return "", fmt.Errorf("repository.Log: %w", err)
+	}
+	defer commits.Close()
+
+	var res string
+	err = commits.ForEach(func(commit *object.Commit) error {
+		tag, ok := tags[commit.Hash]
+		if !ok {
+			return nil
+		}
+
+		res = tag
+		return storer.ErrStop
+	})
+	if err != nil {
+		return "", fmt.Errorf("commits.ForEach: %w", err)
+	}
+
+	if res == "" {
+		return "", core.ErrNoVersionTag
+	}
+
+	return res, nil
+}
+
+// versionTags returns the highest semver tag of every tagged commit, annotated tags are peeled to commits.
+func versionTags(repository *gogit.Repository) (map[plumbing.Hash]string, error) {
+	refs, err := repository.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("repository.Tags: %w", err)
+	}
+	defer refs.Close()
+
+	res := make(map[plumbing.Hash]string)
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if !semver.IsValid(core.CanonicalVersion(name)) {
+			return nil
+		}
+
+		hash := ref.Hash()
+		if tag, err := repository.TagObject(hash); err == nil {
+			commit, err := tag.Commit()
+			if err != nil {
+				// tag of a tree or a blob
+				return nil
+			}
+			hash = commit.Hash
+		}
+
+		if current, ok := res[hash]; !ok || semver.Compare(core.CanonicalVersion(name), core.CanonicalVersion(current)) > 0 {
+			res[hash] = name
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refs.ForEach: %w", err)
+	}
+
+	return res, nil
+}
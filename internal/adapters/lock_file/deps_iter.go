@@ -10,9 +10,10 @@ func (l *LockFile) DepsIter() iter.Seq[models.LockFileInfo] {
 	return func(yield func(models.LockFileInfo) bool) {
 		for moduleName, fileInfo := range l.cache {
 			lockFileInfo := models.LockFileInfo{
-				Name:    moduleName,
-				Version: fileInfo.version,
-				Hash:    models.ModuleHash(fileInfo.hash),
+				Name:     moduleName,
+				Version:  fileInfo.version,
+				Hash:     models.ModuleHash(fileInfo.hash),
+				Indirect: fileInfo.indirect,
 			}
 			if !yield(lockFileInfo) {
 				return
@@ -9,11 +9,17 @@ import (
 
 const (
 	lockFileName = "easyp.lock"
+
+	// indirectSection is a line after which modules required only by other dependencies are listed.
+	// Lines of modules keep three fields, so lock file is still read by versions without indirect modules:
+	// they skip the section line and read indirect modules as direct ones.
+	indirectSection = "// indirect"
 )
 
 type fileInfo struct {
-	version string
-	hash    string
+	version  string
+	hash     string
+	indirect bool
 }
 
 type LockFile struct {
@@ -28,15 +34,23 @@ func New(dirWalker core.DirWalker) *LockFile {
 	if err == nil {
 		fscanner := bufio.NewScanner(fp)
 
+		indirect := false
 		for fscanner.Scan() {
-			parts := strings.Fields(fscanner.Text())
+			line := strings.TrimSpace(fscanner.Text())
+			if line == indirectSection {
+				indirect = true
+				continue
+			}
+
+			parts := strings.Fields(line)
 			if len(parts) != 3 {
 				continue
 			}
 
 			fileInfo := fileInfo{
-				version: parts[1],
-				hash:    parts[2],
+				version:  parts[1],
+				hash:     parts[2],
+				indirect: indirect,
 			}
 			cache[parts[0]] = fileInfo
 		}
@@ -0,0 +1,78 @@
+package lockfile
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+func TestLockFile_Indirect(t *testing.T) {
+	t.Parallel()
+
+	modules := []models.LockFileInfo{
+		{Name: "github.com/acme/api", Version: "v1.0.0", Hash: "h1:api"},
+		{Name: "github.com/googleapis/googleapis", Version: "v1.3.0", Hash: "h1:googleapis", Indirect: true},
+		{Name: "github.com/acme/types", Version: "v0.2.0", Hash: "h1:types", Indirect: true},
+		{Name: "github.com/bufbuild/protovalidate", Version: "v0.8.0", Hash: "h1:protovalidate"},
+	}
+
+	tests := map[string]struct {
+		modules []models.LockFileInfo
+		want    string
+	}{
+		"direct and indirect": {
+			modules: modules,
+			want: "" +
+				"github.com/acme/api v1.0.0 h1:api\n" +
+				"github.com/bufbuild/protovalidate v0.8.0 h1:protovalidate\n" +
+				"\n" +
+				"// indirect\n" +
+				"github.com/acme/types v0.2.0 h1:types\n" +
+				"github.com/googleapis/googleapis v1.3.0 h1:googleapis\n",
+		},
+		"direct only": {
+			modules: modules[:1],
+			want:    "github.com/acme/api v1.0.0 h1:api\n",
+		},
+		"indirect only": {
+			modules: modules[1:2],
+			want: "" +
+				"// indirect\n" +
+				"github.com/googleapis/googleapis v1.3.0 h1:googleapis\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			lockFile := New(fs.NewFSWalker(dir, "."))
+			for _, module := range tc.modules {
+				require.NoError(t, lockFile.Write(module.Name, module.Version, module.Hash, module.Indirect))
+			}
+
+			content, err := os.ReadFile(filepath.Join(dir, lockFileName))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(content))
+
+			// every module line has three fields, so versions without indirect modules still read them
+			for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+				if line != "" && line != indirectSection {
+					require.Len(t, strings.Fields(line), 3)
+				}
+			}
+
+			got := slices.Collect(New(fs.NewFSWalker(dir, ".")).DepsIter())
+			require.ElementsMatch(t, tc.modules, got)
+		})
+	}
+}
@@ -13,9 +13,10 @@ func (l *LockFile) Read(moduleName string) (models.LockFileInfo, error) {
 	}
 
 	lockFileInfo := models.LockFileInfo{
-		Name:    moduleName,
-		Version: fileInfo.version,
-		Hash:    models.ModuleHash(fileInfo.hash),
+		Name:     moduleName,
+		Version:  fileInfo.version,
+		Hash:     models.ModuleHash(fileInfo.hash),
+		Indirect: fileInfo.indirect,
 	}
 	return lockFileInfo, nil
 }
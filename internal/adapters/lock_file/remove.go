@@ -0,0 +1,12 @@
+package lockfile
+
+// Remove deletes module from lock file
+func (l *LockFile) Remove(moduleName string) error {
+	if _, ok := l.cache[moduleName]; !ok {
+		return nil
+	}
+
+	delete(l.cache, moduleName)
+
+	return l.flush()
+}
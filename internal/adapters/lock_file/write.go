@@ -8,29 +8,53 @@ import (
 )
 
 func (l *LockFile) Write(
-	moduleName string, revisionVersion string, installedPackageHash models.ModuleHash,
+	moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool,
 ) error {
-	fp, err := l.dirWalker.Create(lockFileName)
-	if err != nil {
-		return fmt.Errorf("l.dirWalker.Create: %w", err)
-	}
-
 	fileInfo := fileInfo{
-		version: revisionVersion,
-		hash:    string(installedPackageHash),
+		version:  revisionVersion,
+		hash:     string(installedPackageHash),
+		indirect: indirect,
 	}
 
 	l.cache[moduleName] = fileInfo
 
+	return l.flush()
+}
+
+// flush rewrites lock file with modules from cache sorted by name,
+// indirect modules are written after direct ones in their own section.
+func (l *LockFile) flush() error {
+	fp, err := l.dirWalker.Create(lockFileName)
+	if err != nil {
+		return fmt.Errorf("l.dirWalker.Create: %w", err)
+	}
+
 	keys := make([]string, 0, len(l.cache))
 	for k := range l.cache {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
 
-	for _, k := range keys {
-		r := fmt.Sprintf("%s %s %s\n", k, l.cache[k].version, l.cache[k].hash)
-		_, _ = fp.Write([]byte(r))
+	var written, sectionWritten bool
+	for _, indirect := range []bool{false, true} {
+		for _, k := range keys {
+			if l.cache[k].indirect != indirect {
+				continue
+			}
+
+			if indirect && !sectionWritten {
+				section := indirectSection + "\n"
+				if written {
+					section = "\n" + section
+				}
+				_, _ = fp.Write([]byte(section))
+				sectionWritten = true
+			}
+			written = true
+
+			r := fmt.Sprintf("%s %s %s", k, l.cache[k].version, l.cache[k].hash)
+			_, _ = fp.Write([]byte(r + "\n"))
+		}
 	}
 
 	return nil
@@ -76,8 +76,14 @@ func (e *BuiltinPluginExecutor) Execute(ctx context.Context, plugin Info, reques
 	// Create buffer for stdin
 	stdIn := bytes.NewReader(reqData)
 
+	// Get WASM module and arguments for the plugin
+	wasmBin, args, err := getWasmModule(plugin.Source)
+	if err != nil {
+		return nil, fmt.Errorf("get wasm module for plugin %s: %w", plugin.Source, err)
+	}
+
 	// Run WASM plugin
-	stdout, err := e.runWasmPlugin(ctx, plugin.Source, stdIn)
+	stdout, err := runWasmModule(ctx, wasmBin, args, stdIn)
 	if err != nil {
 		return nil, fmt.Errorf("run wasm plugin %s: %w", plugin.Source, err)
 	}
@@ -91,13 +97,9 @@ func (e *BuiltinPluginExecutor) Execute(ctx context.Context, plugin Info, reques
 	return &resp, nil
 }
 
-// runWasmPlugin runs WASM module with custom stdin/stdout
-func (e *BuiltinPluginExecutor) runWasmPlugin(ctx context.Context, pluginName string, stdin io.Reader) ([]byte, error) {
-	// Get WASM module and arguments for the plugin
-	wasmBin, args, err := getWasmModule(pluginName)
-	if err != nil {
-		return nil, fmt.Errorf("get wasm module for plugin %s: %w", pluginName, err)
-	}
+// runWasmModule runs WASM module with custom stdin/stdout
+func runWasmModule(ctx context.Context, wasmBin []byte, args []string, stdin io.Reader) ([]byte, error) {
+	var err error
 
 	// Create context with allocator
 	ctx = experimental.WithMemoryAllocator(ctx, allocator.NewNonMoving())
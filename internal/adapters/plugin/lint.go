@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/easyp-tech/easyp/internal/adapters/console"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+// LintInfo describes lint plugin which has to be executed.
+// Exactly one of Path, Command or Wasm has to be set.
+type LintInfo struct {
+	Path    string   // path to local binary
+	Command []string // custom command with arguments
+	Wasm    string   // path to WASM module
+	Options map[string][]string
+}
+
+// Name returns human-readable name of the plugin.
+func (i LintInfo) Name() string {
+	switch {
+	case i.Wasm != "":
+		return i.Wasm
+	case len(i.Command) != 0:
+		return strings.Join(i.Command, " ")
+	default:
+		return i.Path
+	}
+}
+
+// LintIssue is an issue reported by lint plugin.
+type LintIssue struct {
+	// Path of proto file with issue, it has to be one of the files from file_to_generate.
+	Path string `json:"path"`
+	// Element is a fully-qualified name of element with issue, e.g. `acme.v1.UserService.GetUser`.
+	// It is used for determining position and comments of the issue.
+	Element string `json:"element,omitempty"`
+	// Line and Column override position of the element.
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	Rule   string `json:"rule"`
+	// Message is the description of the issue.
+	Message string `json:"message"`
+}
+
+// LintResponse is a response of lint plugin, plugins write it to stdout as JSON.
+type LintResponse struct {
+	Issues []LintIssue `json:"issues"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// LintExecutor executes lint plugins.
+// Plugin takes serialized CodeGeneratorRequest from stdin:
+// proto_file contains descriptors of linted files with all their imports,
+// file_to_generate contains linted files and parameter contains plugin options.
+// Plugin writes LintResponse to stdout.
+type LintExecutor struct {
+	console console.Console
+	logger  logger.Logger
+}
+
+// NewLintExecutor creates a new LintExecutor
+func NewLintExecutor(console console.Console, logger logger.Logger) *LintExecutor {
+	return &LintExecutor{
+		console: console,
+		logger:  logger,
+	}
+}
+
+// Execute executes lint plugin and returns reported issues.
+func (e *LintExecutor) Execute(ctx context.Context, plugin LintInfo, request *pluginpb.CodeGeneratorRequest) ([]LintIssue, error) {
+	e.logger.Debug(ctx, "executing lint plugin",
+		slog.String("plugin", plugin.Name()),
+	)
+
+	if parameter, ok := flattenOptions(plugin.Options); ok {
+		request.Parameter = proto.String(parameter)
+	}
+
+	reqData, err := proto.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("proto.Marshal request: %w", err)
+	}
+
+	stdout, err := e.run(ctx, plugin, reqData)
+	if err != nil {
+		return nil, fmt.Errorf("run lint plugin %s: %w", plugin.Name(), err)
+	}
+
+	var resp LintResponse
+	if err := json.Unmarshal(stdout, &resp); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal response from lint plugin %s: %w", plugin.Name(), err)
+	}
+
+	if resp.Error != "" {
+		return nil, fmt.Errorf("lint plugin %s: %s", plugin.Name(), resp.Error)
+	}
+
+	for _, issue := range resp.Issues {
+		if issue.Rule == "" {
+			return nil, fmt.Errorf("lint plugin %s: issue without rule name", plugin.Name())
+		}
+	}
+
+	return resp.Issues, nil
+}
+
+func (e *LintExecutor) run(ctx context.Context, plugin LintInfo, reqData []byte) ([]byte, error) {
+	stdIn := bytes.NewReader(reqData)
+
+	switch {
+	case plugin.Wasm != "":
+		wasmBin, err := os.ReadFile(plugin.Wasm)
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile: %w", err)
+		}
+
+		return runWasmModule(ctx, wasmBin, []string{filepath.Base(plugin.Wasm)}, stdIn)
+	case len(plugin.Command) != 0:
+		stdout, err := e.console.RunCmdWithStdin(ctx, ".", stdIn, plugin.Command[0], plugin.Command[1:]...)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(stdout), nil
+	case plugin.Path != "":
+		stdout, err := e.console.RunCmdWithStdin(ctx, ".", stdIn, plugin.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(stdout), nil
+	default:
+		return nil, errors.New("one of path, command or wasm has to be set")
+	}
+}
@@ -0,0 +1,41 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+// lintPluginWasm writes the fixed response, see plugin.wat.
+const lintPluginWasm = "../../../testdata/lint_plugin_wasm/plugin.wasm"
+
+func TestLintExecutor_Wasm(t *testing.T) {
+	t.Parallel()
+
+	executor := NewLintExecutor(nil, logger.NewNop())
+
+	issues, err := executor.Execute(context.Background(), LintInfo{
+		Wasm:    lintPluginWasm,
+		Options: map[string][]string{"strict": {"true"}},
+	}, &pluginpb.CodeGeneratorRequest{FileToGenerate: []string{"acme/v1/user.proto"}})
+	require.NoError(t, err)
+	require.Equal(t, []LintIssue{{
+		Path:    "acme/v1/user.proto",
+		Element: "acme.v1.User",
+		Rule:    "ACME_WASM",
+		Message: "reported by wasm plugin",
+	}}, issues)
+}
+
+func TestLintExecutor_WasmNotFound(t *testing.T) {
+	t.Parallel()
+
+	executor := NewLintExecutor(nil, logger.NewNop())
+
+	_, err := executor.Execute(context.Background(), LintInfo{Wasm: "not_found.wasm"}, &pluginpb.CodeGeneratorRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// gitPeeledTagSuffix marks commit of annotated tag in ls-remote output
+const gitPeeledTagSuffix = "^{}"
+
+func (r *gitRepo) Tags(ctx context.Context) ([]string, error) {
+	res, err := r.lsRemote(ctx, "--tags")
+	if err != nil {
+		return nil, fmt.Errorf("r.lsRemote: %w", err)
+	}
+
+	tags := make([]string, 0, len(res))
+	seen := make(map[string]struct{}, len(res))
+
+	for _, lsOut := range res {
+		rev := strings.Fields(lsOut)
+		if len(rev) != 2 || !strings.HasPrefix(rev[1], gitRefsTagPrefix) {
+			continue
+		}
+
+		// annotated tags are listed twice: as tag object and as peeled commit
+		gitTag := strings.TrimSuffix(strings.TrimPrefix(rev[1], gitRefsTagPrefix), gitPeeledTagSuffix)
+		if _, ok := seen[gitTag]; ok {
+			continue
+		}
+
+		seen[gitTag] = struct{}{}
+		tags = append(tags, gitTag)
+	}
+
+	return tags, nil
+}
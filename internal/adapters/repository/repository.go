@@ -24,4 +24,7 @@ type Repo interface {
 
 	// Fetch from remote repository specified version
 	Fetch(ctx context.Context, revision models.Revision) error
+
+	// Tags returns names of all tags of remote repository
+	Tags(ctx context.Context) ([]string, error)
 }
@@ -20,13 +20,48 @@ var _ Handler = (*BreakingCheck)(nil)
 // BreakingCheck is a handler for breaking command
 type BreakingCheck struct{}
 
+// Diff modes of breaking command.
+const (
+	breakingModeBreaking  = "breaking"
+	breakingModeAdditions = "additions"
+)
+
 var (
 	flagAgainstBranchName = &cli.StringFlag{
-		Name:       "against",
-		Usage:      "set branch to compare with",
-		Required:   true,
-		HasBeenSet: true,
-		Value:      "master",
+		Name:     "against",
+		Usage:    "set git ref (branch, tag, commit) to compare with (default: against_git_ref of config or master)",
+		Required: false,
+	}
+
+	flagAgainstModule = &cli.StringFlag{
+		Name:     "against-module",
+		Usage:    "set module version (e.g. github.com/acme/apis@v1.4.0) to compare with instead of git ref",
+		Required: false,
+	}
+
+	flagAgainstInput = &cli.StringFlag{
+		Name:     "against-input",
+		Usage:    "set directory, archive (.tar.gz, .tgz, .zip) or descriptor set (.binpb, .pb, .bin) to compare with instead of git ref",
+		Required: false,
+	}
+
+	flagBreakingMode = &cli.GenericFlag{
+		Name:     "mode",
+		Usage:    "set diff mode: breaking reports breaking changes, additions lists added elements",
+		Required: false,
+		Value: &flags.EnumValue{
+			Enum: []string{
+				breakingModeBreaking,
+				breakingModeAdditions,
+			},
+			Default: breakingModeBreaking,
+		},
+	}
+
+	flagSuggestVersion = &cli.BoolFlag{
+		Name:     "suggest-version",
+		Usage:    "suggest the next semantic version by changes against the last version tag",
+		Required: false,
 	}
 
 	flagBreakingCheckRoot = &cli.StringFlag{
@@ -38,9 +73,12 @@ var (
 		Aliases:    []string{"r"},
 	}
 
-	ErrBreakingCheckIssue = errors.New("has breaking check issue")
+	ErrBreakingCheckIssue  = errors.New("has breaking check issue")
+	ErrAgainstFlagConflict = errors.New("only one of --against, --against-module and --against-input can be set")
 )
 
+const defaultAgainstGitRef = "master"
+
 func (b BreakingCheck) Command() *cli.Command {
 	return &cli.Command{
 		Name:         "breaking",
@@ -58,6 +96,10 @@ func (b BreakingCheck) Command() *cli.Command {
 		Flags: []cli.Flag{
 			flagLintDirectoryPath,
 			flagAgainstBranchName,
+			flagAgainstModule,
+			flagAgainstInput,
+			flagBreakingMode,
+			flagSuggestVersion,
 			flagBreakingCheckRoot,
 		},
 		SkipFlagParsing:        false,
@@ -87,6 +129,12 @@ func (b BreakingCheck) Action(ctx *cli.Context) error {
 			errExit(log, 2, "Cannot find git ref", slog.String("ref", g.GitRef))
 		case errors.Is(err, core.ErrRepositoryDoesNotExist):
 			errExit(log, 2, "Repository does not exist in current directory")
+		case errors.Is(err, ErrAgainstFlagConflict):
+			errExit(log, 2, "Only one of --against, --against-module and --against-input can be set")
+		case errors.Is(err, core.ErrAgainstConflict):
+			errExit(log, 2, "Only one of against_module and against_input can be set in config")
+		case errors.Is(err, core.ErrUnknownAgainstInput):
+			errExit(log, 2, "Unknown --against-input, expected directory, archive or descriptor set")
 		default:
 			return err
 		}
@@ -107,9 +155,13 @@ func (b BreakingCheck) action(ctx *cli.Context, log logger.Logger) error {
 	}
 
 	path := ctx.String(flagLintDirectoryPath.Name)
-	against := ctx.String(flagAgainstBranchName.Name)
-	if cfg.BreakingCheck.AgainstGitRef == "" {
-		cfg.BreakingCheck.AgainstGitRef = against
+	err = applyAgainstFlags(&cfg.BreakingCheck,
+		ctx.String(flagAgainstBranchName.Name),
+		ctx.String(flagAgainstModule.Name),
+		ctx.String(flagAgainstInput.Name),
+	)
+	if err != nil {
+		return fmt.Errorf("applyAgainstFlags: %w", err)
 	}
 
 	// Walker for Core (lockfile etc) - strictly based on project root
@@ -119,23 +171,74 @@ func (b BreakingCheck) action(ctx *cli.Context, log logger.Logger) error {
 		return fmt.Errorf("buildCore: %w", err)
 	}
 
+	format := flags.GetFormat(ctx, flags.TextFormat)
+
+	switch {
+	case ctx.Bool(flagSuggestVersion.Name):
+		suggestion, err := app.SuggestVersion(ctx.Context, breakingCheckRoot, path)
+		if err != nil {
+			return fmt.Errorf("app.SuggestVersion: %w", err)
+		}
+
+		if err := printVersionSuggestion(format, os.Stdout, suggestion); err != nil {
+			return fmt.Errorf("printVersionSuggestion: %w", err)
+		}
+
+		return nil
+	case ctx.String(flagBreakingMode.Name) == breakingModeAdditions:
+		additions, err := app.BreakingAdditions(ctx.Context, breakingCheckRoot, path)
+		if err != nil {
+			return fmt.Errorf("app.BreakingAdditions: %w", err)
+		}
+
+		if err := printAdditions(format, os.Stdout, additions); err != nil {
+			return fmt.Errorf("printAdditions: %w", err)
+		}
+
+		return nil
+	}
+
 	issues, err := app.BreakingCheck(ctx.Context, projectRoot, breakingCheckRoot, path)
 	if err != nil {
 		return fmt.Errorf("app.BreakingCheck: %w", err)
 	}
 
+	if err := printBreakingIssues(format, os.Stdout, issues); err != nil {
+		return fmt.Errorf("printBreakingIssues: %w", err)
+	}
+
 	if len(issues) == 0 {
 		return nil
 	}
 
-	format := flags.GetFormat(ctx, flags.TextFormat)
-	if err := printIssues(
-		format,
-		os.Stdout,
-		issues,
-	); err != nil {
-		return fmt.Errorf("printLintErrors: %w", err)
+	return ErrBreakingCheckIssue
+}
+
+// applyAgainstFlags overrides against sources of config by flags.
+// A set flag replaces all against sources of config, so e.g. against_module of config doesn't win over --against.
+// Git ref is still used as the fallback of --suggest-version, so it's defaulted if it's not set anywhere.
+func applyAgainstFlags(cfg *config.BreakingCheck, gitRef, module, input string) error {
+	var setCount int
+	for _, value := range []string{gitRef, module, input} {
+		if value != "" {
+			setCount++
+		}
 	}
 
-	return ErrBreakingCheckIssue
+	switch {
+	case setCount > 1:
+		return ErrAgainstFlagConflict
+	case gitRef != "":
+		cfg.AgainstGitRef, cfg.AgainstModule, cfg.AgainstInput = gitRef, "", ""
+	case module != "":
+		cfg.AgainstModule, cfg.AgainstInput = module, ""
+	case input != "":
+		cfg.AgainstModule, cfg.AgainstInput = "", input
+	}
+
+	if cfg.AgainstGitRef == "" {
+		cfg.AgainstGitRef = defaultAgainstGitRef
+	}
+
+	return nil
 }
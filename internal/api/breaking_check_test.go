@@ -0,0 +1,80 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/config"
+)
+
+func TestApplyAgainstFlags(t *testing.T) {
+	t.Parallel()
+
+	const (
+		module = "github.com/acme/apis@v1.4.0"
+		input  = "apis-v1.4.0.binpb"
+	)
+
+	tests := map[string]struct {
+		cfg     config.BreakingCheck
+		gitRef  string
+		module  string
+		input   string
+		want    config.BreakingCheck
+		wantErr error
+	}{
+		"default": {
+			want: config.BreakingCheck{AgainstGitRef: "master"},
+		},
+		"config": {
+			cfg:  config.BreakingCheck{AgainstGitRef: "main", AgainstModule: module},
+			want: config.BreakingCheck{AgainstGitRef: "main", AgainstModule: module},
+		},
+		"git ref overrides config git ref": {
+			cfg:    config.BreakingCheck{AgainstGitRef: "main"},
+			gitRef: "v1.4.0",
+			want:   config.BreakingCheck{AgainstGitRef: "v1.4.0"},
+		},
+		"git ref overrides config module": {
+			cfg:    config.BreakingCheck{AgainstModule: module},
+			gitRef: "v1.4.0",
+			want:   config.BreakingCheck{AgainstGitRef: "v1.4.0"},
+		},
+		"module overrides config input": {
+			cfg:    config.BreakingCheck{AgainstGitRef: "main", AgainstInput: input},
+			module: module,
+			want:   config.BreakingCheck{AgainstGitRef: "main", AgainstModule: module},
+		},
+		"input overrides config module": {
+			cfg:   config.BreakingCheck{AgainstModule: module},
+			input: input,
+			want:  config.BreakingCheck{AgainstGitRef: "master", AgainstInput: input},
+		},
+		"git ref and module": {
+			gitRef:  "v1.4.0",
+			module:  module,
+			wantErr: ErrAgainstFlagConflict,
+		},
+		"module and input": {
+			module:  module,
+			input:   input,
+			wantErr: ErrAgainstFlagConflict,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := tc.cfg
+			err := applyAgainstFlags(&cfg, tc.gitRef, tc.module, tc.input)
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+
+			require.Equal(t, tc.want, cfg)
+		})
+	}
+}
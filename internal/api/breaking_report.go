@@ -0,0 +1,117 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+// printBreakingIssues prints breaking issues, json-report and markdown formats print the report
+// grouped by package, service and message with suggested fixes, other formats are printed as lint issues.
+func printBreakingIssues(format string, w io.Writer, issues []core.IssueInfo) error {
+	switch format {
+	case flags.JSONReportFormat:
+		return breakingReportJSONPrinter(w, core.NewBreakingReport(issues))
+	case flags.MarkdownFormat:
+		return breakingReportMarkdownPrinter(w, core.NewBreakingReport(issues))
+	default:
+		return printIssues(format, w, issues, issueReport{Name: "breaking"})
+	}
+}
+
+// breakingReportJSONPrinter prints breaking report in json format.
+func breakingReportJSONPrinter(w io.Writer, report core.BreakingReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("json.Encode: %w", err)
+	}
+
+	return nil
+}
+
+// breakingReportMarkdownPrinter prints breaking report in markdown format: summary table with counts
+// of issues per category for every group and a table of issues with suggested fixes for every group.
+func breakingReportMarkdownPrinter(w io.Writer, report core.BreakingReport) error {
+	b := &strings.Builder{}
+	categories := core.BreakingCategories()
+
+	b.WriteString("## Breaking changes\n\n")
+
+	if report.Total == 0 {
+		b.WriteString("No breaking changes found.\n")
+		return writeString(w, b.String())
+	}
+
+	counts := make([]string, 0, len(categories))
+	for _, category := range categories {
+		counts = append(counts, fmt.Sprintf("%s %d", category, report.Categories[category]))
+	}
+	fmt.Fprintf(b, "Found %d breaking changes: %s.\n\n", report.Total, strings.Join(counts, ", "))
+
+	b.WriteString("| Package | Service | Message | Total | " + strings.Join(categories, " | ") + " |\n")
+	b.WriteString("|---|---|---|---:|" + strings.Repeat("---:|", len(categories)) + "\n")
+	for _, group := range report.Groups {
+		row := []string{
+			markdownCode(group.Package),
+			markdownCode(group.Service),
+			markdownCode(group.Message),
+			strconv.Itoa(group.Total),
+		}
+		for _, category := range categories {
+			row = append(row, strconv.Itoa(group.Categories[category]))
+		}
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+
+	for _, group := range report.Groups {
+		name := group.Name()
+		if name == "" {
+			name = "(no package)"
+		}
+
+		fmt.Fprintf(b, "\n### %s\n\n", markdownCode(name))
+		b.WriteString("| Location | Rule | Issue | Suggested fix |\n")
+		b.WriteString("|---|---|---|---|\n")
+		for _, issue := range group.Issues {
+			location := fmt.Sprintf("%s:%d:%d", issue.Path, issue.Position.Line, issue.Position.Column)
+			fmt.Fprintf(b, "| %s | %s | %s | %s |\n",
+				markdownCode(location),
+				markdownCode(issue.RuleName),
+				markdownCell(issue.Message),
+				markdownCell(issue.Hint),
+			)
+		}
+	}
+
+	return writeString(w, b.String())
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	return "`" + s + "`"
+}
+
+// markdownCell escapes text for a table cell, cells can't contain pipes and line breaks.
+func markdownCell(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.ReplaceAll(s, "|", `\|`)
+
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func writeString(w io.Writer, s string) error {
+	if _, err := io.WriteString(w, s); err != nil {
+		return fmt.Errorf("io.WriteString: %w", err)
+	}
+
+	return nil
+}
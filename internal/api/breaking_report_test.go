@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+func testBreakingIssues() []core.IssueInfo {
+	return []core.IssueInfo{
+		{
+			Issue: core.Issue{
+				Position: meta.Position{Line: 12, Column: 1},
+				Message:  "Previously present RPC \"DeleteUser\" on service \"UserService\" was deleted.",
+				RuleName: "RPC_NO_DELETE",
+				Severity: core.SeverityError,
+				Hint:     "Add the RPC \"DeleteUser\" back and mark it deprecated.",
+			},
+			Path:  "acme/v1/service.proto",
+			Scope: core.IssueScope{Package: "acme.v1", Service: "UserService"},
+		},
+		{
+			Issue: core.Issue{
+				Position: meta.Position{Line: 5, Column: 1},
+				Message:  "Previously present field \"5\" with name \"foo\" on message \"User\" was deleted.\n",
+				RuleName: "FIELD_NO_DELETE",
+				Severity: core.SeverityError,
+				Hint:     "Add field \"foo\" back and mark it deprecated, or reserve field number 5 and name \"foo\".",
+			},
+			Path:  "acme/v1/user.proto",
+			Scope: core.IssueScope{Package: "acme.v1", Message: "User"},
+		},
+	}
+}
+
+func TestPrintBreakingIssues_Markdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printBreakingIssues(flags.MarkdownFormat, &buf, testBreakingIssues()))
+
+	require.Equal(t, ""+
+		"## Breaking changes\n"+
+		"\n"+
+		"Found 2 breaking changes: FILE 2, PACKAGE 2, WIRE_JSON 0, WIRE 0.\n"+
+		"\n"+
+		"| Package | Service | Message | Total | FILE | PACKAGE | WIRE_JSON | WIRE |\n"+
+		"|---|---|---|---:|---:|---:|---:|---:|\n"+
+		"| `acme.v1` |  | `User` | 1 | 1 | 1 | 0 | 0 |\n"+
+		"| `acme.v1` | `UserService` |  | 1 | 1 | 1 | 0 | 0 |\n"+
+		"\n"+
+		"### `acme.v1.User`\n"+
+		"\n"+
+		"| Location | Rule | Issue | Suggested fix |\n"+
+		"|---|---|---|---|\n"+
+		"| `acme/v1/user.proto:5:1` | `FIELD_NO_DELETE` | "+
+		"Previously present field \"5\" with name \"foo\" on message \"User\" was deleted. | "+
+		"Add field \"foo\" back and mark it deprecated, or reserve field number 5 and name \"foo\". |\n"+
+		"\n"+
+		"### `acme.v1.UserService`\n"+
+		"\n"+
+		"| Location | Rule | Issue | Suggested fix |\n"+
+		"|---|---|---|---|\n"+
+		"| `acme/v1/service.proto:12:1` | `RPC_NO_DELETE` | "+
+		"Previously present RPC \"DeleteUser\" on service \"UserService\" was deleted. | "+
+		"Add the RPC \"DeleteUser\" back and mark it deprecated. |\n",
+		buf.String(),
+	)
+}
+
+func TestPrintBreakingIssues_MarkdownNoIssues(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printBreakingIssues(flags.MarkdownFormat, &buf, nil))
+
+	require.Equal(t, "## Breaking changes\n\nNo breaking changes found.\n", buf.String())
+}
+
+func TestPrintBreakingIssues_JSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printBreakingIssues(flags.JSONFormat, &buf, testBreakingIssues()))
+
+	// json format prints issues like before the report was added
+	var want bytes.Buffer
+	require.NoError(t, printIssues(flags.JSONFormat, &want, testBreakingIssues(), issueReport{Name: "breaking"}))
+	require.Equal(t, want.String(), buf.String())
+}
+
+func TestPrintBreakingIssues_JSONReport(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printBreakingIssues(flags.JSONReportFormat, &buf, testBreakingIssues()))
+
+	var got core.BreakingReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	require.Equal(t, core.NewBreakingReport(testBreakingIssues()), got)
+}
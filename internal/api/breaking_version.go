@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+// printAdditions prints added elements in text or json format.
+func printAdditions(format string, w io.Writer, additions []core.Addition) error {
+	switch format {
+	case flags.TextFormat:
+		b := &strings.Builder{}
+		for _, addition := range additions {
+			fmt.Fprintf(b, "%s:%d:%d: added %s \"%s\"\n",
+				addition.Path, addition.Position.Line, addition.Position.Column, addition.Kind, addition.Name,
+			)
+		}
+
+		return writeString(w, b.String())
+	case flags.JSONFormat:
+		for _, addition := range additions {
+			if err := json.NewEncoder(w).Encode(addition); err != nil {
+				return fmt.Errorf("json.NewEncoder.Encode: %w", err)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// printVersionSuggestion prints suggested version in text or json format,
+// the first word of text format is the next version, so it can be used in scripts.
+func printVersionSuggestion(format string, w io.Writer, suggestion core.VersionSuggestion) error {
+	switch format {
+	case flags.TextFormat:
+		b := &strings.Builder{}
+		fmt.Fprintf(b, "%s (%s: %d breaking changes, %d additions since %s)\n",
+			suggestion.Next, suggestion.Bump, suggestion.Breaking, suggestion.Additions, suggestion.Current,
+		)
+		for _, pkg := range slices.Sorted(maps.Keys(suggestion.Packages)) {
+			fmt.Fprintf(b, "or keep %s and add breaking changes to new package %s\n", pkg, suggestion.Packages[pkg])
+		}
+
+		return writeString(w, b.String())
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(suggestion); err != nil {
+			return fmt.Errorf("json.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
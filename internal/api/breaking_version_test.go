@@ -0,0 +1,48 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+func TestPrintAdditions_Text(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printAdditions(flags.TextFormat, &buf, []core.Addition{
+		{
+			Kind:     core.AdditionKindField,
+			Name:     "acme.v1.User.email",
+			Path:     "acme/v1/user.proto",
+			Position: meta.Position{Line: 5, Column: 3},
+		},
+	}))
+
+	require.Equal(t, "acme/v1/user.proto:5:3: added field \"acme.v1.User.email\"\n", buf.String())
+}
+
+func TestPrintVersionSuggestion_Text(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printVersionSuggestion(flags.TextFormat, &buf, core.VersionSuggestion{
+		Current:   "v1.4.0",
+		Next:      "v2.0.0",
+		Bump:      core.VersionBumpMajor,
+		Breaking:  1,
+		Additions: 2,
+		Packages:  map[string]string{"acme.v1": "acme.v2"},
+	}))
+
+	require.Equal(t, ""+
+		"v2.0.0 (major: 1 breaking changes, 2 additions since v1.4.0)\n"+
+		"or keep acme.v1 and add breaking changes to new package acme.v2\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,460 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+	"github.com/easyp-tech/easyp/internal/version"
+)
+
+const (
+	toolName           = "easyp"
+	toolInformationURI = "https://easyp.tech"
+	sarifSchema        = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion       = "2.1.0"
+	checkstyleVersion  = "5.0"
+)
+
+// issueReport describes the command which found issues, it is used by CI formats.
+type issueReport struct {
+	// Name of the command: lint, breaking.
+	Name string
+	// Rules contains metadata of known rules by name,
+	// message of the first issue is used for unknown rules (e.g. from plugins).
+	Rules map[string]rules.RuleInfo
+}
+
+// lintIssueReport returns report for issues of builtin lint rules.
+func lintIssueReport() issueReport {
+	return issueReport{
+		Name: "lint",
+		Rules: lo.SliceToMap(rules.AllRules(), func(rule rules.RuleInfo) (string, rules.RuleInfo) {
+			return rule.Name, rule
+		}),
+	}
+}
+
+// issueRules returns metadata of rules of passed issues in order of their first appearance.
+func (r issueReport) issueRules(issues []core.IssueInfo) []rules.RuleInfo {
+	var res []rules.RuleInfo
+	seen := make(map[string]bool)
+
+	for _, issue := range issues {
+		if seen[issue.RuleName] {
+			continue
+		}
+		seen[issue.RuleName] = true
+
+		rule, ok := r.Rules[issue.RuleName]
+		if !ok {
+			rule = rules.RuleInfo{
+				Name:    issue.RuleName,
+				Message: issue.Message,
+			}
+		}
+
+		res = append(res, rule)
+	}
+
+	return res
+}
+
+// issueSeverity returns severity of issue, issues without severity are errors.
+func issueSeverity(issue core.IssueInfo) core.Severity {
+	if issue.Severity == "" {
+		return core.SeverityError
+	}
+
+	return issue.Severity
+}
+
+// issueMessage returns message of issue with the name of element.
+func issueMessage(issue core.IssueInfo) string {
+	if issue.SourceName == "" {
+		return issue.Message
+	}
+
+	return fmt.Sprintf("%s: %s", issue.SourceName, issue.Message)
+}
+
+type (
+	sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		Version        string      `json:"version,omitempty"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID               string               `json:"id"`
+		ShortDescription sarifMessage         `json:"shortDescription"`
+		Properties       *sarifRuleProperties `json:"properties,omitempty"`
+	}
+
+	sarifRuleProperties struct {
+		Tags []string `json:"tags"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		RuleIndex int             `json:"ruleIndex"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+)
+
+// sarifLevel returns SARIF level of result with passed severity.
+func sarifLevel(severity core.Severity) string {
+	switch severity {
+	case core.SeverityWarning:
+		return "warning"
+	case core.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+// sarifPrinter prints issues in SARIF 2.1.0 format.
+func sarifPrinter(w io.Writer, issues []core.IssueInfo, report issueReport) error {
+	issueRules := report.issueRules(issues)
+	ruleIndexes := make(map[string]int, len(issueRules))
+
+	driver := sarifDriver{
+		Name:           toolName,
+		Version:        version.System(),
+		InformationURI: toolInformationURI,
+		Rules:          make([]sarifRule, 0, len(issueRules)),
+	}
+	for i, rule := range issueRules {
+		ruleIndexes[rule.Name] = i
+
+		sRule := sarifRule{
+			ID:               rule.Name,
+			ShortDescription: sarifMessage{Text: rule.Message},
+		}
+		if len(rule.Groups) != 0 {
+			sRule.Properties = &sarifRuleProperties{Tags: rule.Groups}
+		}
+
+		driver.Rules = append(driver.Rules, sRule)
+	}
+
+	results := make([]sarifResult, 0, len(issues))
+	for _, issue := range issues {
+		location := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: issue.Path},
+			},
+		}
+		if issue.Position.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{
+				StartLine:   issue.Position.Line,
+				StartColumn: issue.Position.Column,
+			}
+		}
+
+		results = append(results, sarifResult{
+			RuleID:    issue.RuleName,
+			RuleIndex: ruleIndexes[issue.RuleName],
+			Level:     sarifLevel(issueSeverity(issue)),
+			Message:   sarifMessage{Text: issueMessage(issue)},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: driver},
+			Results: results,
+		}},
+	}); err != nil {
+		return fmt.Errorf("json.Encode: %w", err)
+	}
+
+	return nil
+}
+
+type (
+	junitTestSuites struct {
+		XMLName    xml.Name         `xml:"testsuites"`
+		Name       string           `xml:"name,attr"`
+		Tests      int              `xml:"tests,attr"`
+		Failures   int              `xml:"failures,attr"`
+		TestSuites []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name      string          `xml:"name,attr"`
+		Tests     int             `xml:"tests,attr"`
+		Failures  int             `xml:"failures,attr"`
+		TestCases []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string       `xml:"name,attr"`
+		ClassName string       `xml:"classname,attr"`
+		Failure   junitFailure `xml:"failure"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Content string `xml:",chardata"`
+	}
+)
+
+// junitPrinter prints issues in JUnit XML format: one test suite per file, one failed test case per issue.
+func junitPrinter(w io.Writer, issues []core.IssueInfo, report issueReport) error {
+	suites := junitTestSuites{
+		Name:     toolName + " " + report.Name,
+		Tests:    len(issues),
+		Failures: len(issues),
+	}
+
+	for _, issue := range issues {
+		if len(suites.TestSuites) == 0 || suites.TestSuites[len(suites.TestSuites)-1].Name != issue.Path {
+			suites.TestSuites = append(suites.TestSuites, junitTestSuite{Name: issue.Path})
+		}
+
+		suite := &suites.TestSuites[len(suites.TestSuites)-1]
+		suite.Tests++
+		suite.Failures++
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      issue.RuleName,
+			ClassName: fmt.Sprintf("%s:%d:%d", issue.Path, issue.Position.Line, issue.Position.Column),
+			Failure: junitFailure{
+				Message: issueMessage(issue),
+				Type:    string(issueSeverity(issue)),
+				Content: fmt.Sprintf("%s:%d:%d: %s (%s)",
+					issue.Path, issue.Position.Line, issue.Position.Column, issueMessage(issue), issue.RuleName,
+				),
+			},
+		})
+	}
+
+	return writeXML(w, suites)
+}
+
+type (
+	checkstyleReport struct {
+		XMLName xml.Name         `xml:"checkstyle"`
+		Version string           `xml:"version,attr"`
+		Files   []checkstyleFile `xml:"file"`
+	}
+
+	checkstyleFile struct {
+		Name   string            `xml:"name,attr"`
+		Errors []checkstyleError `xml:"error"`
+	}
+
+	checkstyleError struct {
+		Line     int    `xml:"line,attr"`
+		Column   int    `xml:"column,attr"`
+		Severity string `xml:"severity,attr"`
+		Message  string `xml:"message,attr"`
+		Source   string `xml:"source,attr"`
+	}
+)
+
+// checkstylePrinter prints issues in Checkstyle XML format.
+func checkstylePrinter(w io.Writer, issues []core.IssueInfo, report issueReport) error {
+	res := checkstyleReport{Version: checkstyleVersion}
+
+	for _, issue := range issues {
+		if len(res.Files) == 0 || res.Files[len(res.Files)-1].Name != issue.Path {
+			res.Files = append(res.Files, checkstyleFile{Name: issue.Path})
+		}
+
+		file := &res.Files[len(res.Files)-1]
+		file.Errors = append(file.Errors, checkstyleError{
+			Line:     issue.Position.Line,
+			Column:   issue.Position.Column,
+			Severity: string(issueSeverity(issue)),
+			Message:  issueMessage(issue),
+			Source:   toolName + "." + report.Name + "." + issue.RuleName,
+		})
+	}
+
+	return writeXML(w, res)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("io.WriteString: %w", err)
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("xml.Encode: %w", err)
+	}
+
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("io.WriteString: %w", err)
+	}
+
+	return nil
+}
+
+// githubActionsPrinter prints issues as GitHub Actions workflow commands,
+// so they are shown as inline annotations.
+func githubActionsPrinter(w io.Writer, issues []core.IssueInfo) error {
+	for _, issue := range issues {
+		params := []string{"file=" + escapeGitHubProperty(issue.Path)}
+		if issue.Position.Line > 0 {
+			params = append(params, "line="+strconv.Itoa(issue.Position.Line))
+		}
+		if issue.Position.Column > 0 {
+			params = append(params, "col="+strconv.Itoa(issue.Position.Column))
+		}
+		params = append(params, "title="+escapeGitHubProperty(issue.RuleName))
+
+		_, err := fmt.Fprintf(w, "::%s %s::%s\n",
+			githubActionsCommand(issueSeverity(issue)),
+			strings.Join(params, ","),
+			escapeGitHubData(issueMessage(issue)),
+		)
+		if err != nil {
+			return fmt.Errorf("fmt.Fprintf: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// githubActionsCommand returns workflow command of annotation with passed severity.
+func githubActionsCommand(severity core.Severity) string {
+	switch severity {
+	case core.SeverityWarning:
+		return "warning"
+	case core.SeverityInfo:
+		return "notice"
+	default:
+		return "error"
+	}
+}
+
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+type (
+	gitlabCodeQualityIssue struct {
+		Description string                    `json:"description"`
+		CheckName   string                    `json:"check_name"`
+		Fingerprint string                    `json:"fingerprint"`
+		Severity    string                    `json:"severity"`
+		Location    gitlabCodeQualityLocation `json:"location"`
+	}
+
+	gitlabCodeQualityLocation struct {
+		Path  string                 `json:"path"`
+		Lines gitlabCodeQualityLines `json:"lines"`
+	}
+
+	gitlabCodeQualityLines struct {
+		Begin int `json:"begin"`
+	}
+)
+
+// gitlabCodeQualitySeverity returns GitLab Code Quality severity of issue with passed severity.
+func gitlabCodeQualitySeverity(severity core.Severity) string {
+	switch severity {
+	case core.SeverityWarning:
+		return "minor"
+	case core.SeverityInfo:
+		return "info"
+	default:
+		return "major"
+	}
+}
+
+// gitlabCodeQualityPrinter prints issues in GitLab Code Quality format.
+// Fingerprint doesn't depend on position, so issues are tracked between merge requests.
+func gitlabCodeQualityPrinter(w io.Writer, issues []core.IssueInfo) error {
+	res := make([]gitlabCodeQualityIssue, 0, len(issues))
+	seen := make(map[string]int)
+
+	for _, issue := range issues {
+		key := strings.Join([]string{issue.Path, issue.RuleName, core.IssueFingerprint(issue)}, "\x00")
+		seen[key]++
+
+		h := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(seen[key])))
+
+		res = append(res, gitlabCodeQualityIssue{
+			Description: issueMessage(issue),
+			CheckName:   issue.RuleName,
+			Fingerprint: hex.EncodeToString(h[:16]),
+			Severity:    gitlabCodeQualitySeverity(issueSeverity(issue)),
+			Location: gitlabCodeQualityLocation{
+				Path:  issue.Path,
+				Lines: gitlabCodeQualityLines{Begin: max(issue.Position.Line, 1)},
+			},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(res); err != nil {
+		return fmt.Errorf("json.Encode: %w", err)
+	}
+
+	return nil
+}
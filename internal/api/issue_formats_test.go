@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+func testIssues() []core.IssueInfo {
+	return []core.IssueInfo{
+		{
+			Issue: core.Issue{
+				Position:   meta.Position{Line: 3, Column: 1},
+				SourceName: "User",
+				Message:    "message comments must not be empty",
+				RuleName:   "COMMENT_MESSAGE",
+			},
+			Path: "acme/v1/user.proto",
+		},
+		{
+			Issue: core.Issue{
+				Position:   meta.Position{Line: 4, Column: 3},
+				SourceName: "id",
+				Message:    "field comments must not be empty",
+				RuleName:   "COMMENT_FIELD",
+				Severity:   core.SeverityWarning,
+			},
+			Path: "acme/v1/user.proto",
+		},
+		{
+			Issue: core.Issue{
+				Position: meta.Position{Line: 1, Column: 1},
+				Message:  "custom: 100%, really",
+				RuleName: "ACME_CUSTOM",
+				Severity: core.SeverityInfo,
+			},
+			Path: "acme/v1/order.proto",
+		},
+	}
+}
+
+func TestPrintIssues_SARIF(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printIssues(flags.SARIFFormat, &buf, testIssues(), lintIssueReport()))
+
+	var got sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	require.Equal(t, sarifVersion, got.Version)
+	require.Len(t, got.Runs, 1)
+
+	driver := got.Runs[0].Tool.Driver
+	require.Equal(t, []sarifRule{
+		{
+			ID:               "COMMENT_MESSAGE",
+			ShortDescription: sarifMessage{Text: "message comments must not be empty"},
+			Properties:       &sarifRuleProperties{Tags: []string{"COMMENTS"}},
+		},
+		{
+			ID:               "COMMENT_FIELD",
+			ShortDescription: sarifMessage{Text: "field comments must not be empty"},
+			Properties:       &sarifRuleProperties{Tags: []string{"COMMENTS"}},
+		},
+		{
+			ID:               "ACME_CUSTOM",
+			ShortDescription: sarifMessage{Text: "custom: 100%, really"},
+		},
+	}, driver.Rules)
+
+	results := got.Runs[0].Results
+	require.Len(t, results, 3)
+	require.Equal(t, 1, results[1].RuleIndex)
+	require.Equal(t, "id: field comments must not be empty", results[1].Message.Text)
+	require.Equal(t, &sarifRegion{StartLine: 4, StartColumn: 3}, results[1].Locations[0].PhysicalLocation.Region)
+	require.Equal(t, []string{"error", "warning", "note"}, []string{results[0].Level, results[1].Level, results[2].Level})
+}
+
+func TestPrintIssues_JUnit(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printIssues(flags.JUnitFormat, &buf, testIssues(), issueReport{Name: "breaking"}))
+
+	var got junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+	require.Equal(t, "easyp breaking", got.Name)
+	require.Equal(t, 3, got.Failures)
+	require.Len(t, got.TestSuites, 2)
+	require.Equal(t, "acme/v1/user.proto", got.TestSuites[0].Name)
+	require.Equal(t, 2, got.TestSuites[0].Tests)
+	require.Equal(t, "acme/v1/user.proto:4:3", got.TestSuites[0].TestCases[1].ClassName)
+	require.Equal(t, "warning", got.TestSuites[0].TestCases[1].Failure.Type)
+}
+
+func TestPrintIssues_Checkstyle(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printIssues(flags.CheckstyleFormat, &buf, testIssues(), lintIssueReport()))
+
+	var got checkstyleReport
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+	require.Len(t, got.Files, 2)
+	require.Equal(t, checkstyleError{
+		Line:     4,
+		Column:   3,
+		Severity: "warning",
+		Message:  "id: field comments must not be empty",
+		Source:   "easyp.lint.COMMENT_FIELD",
+	}, got.Files[0].Errors[1])
+}
+
+func TestPrintIssues_GitHubActions(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printIssues(flags.GitHubActionsFormat, &buf, testIssues(), lintIssueReport()))
+
+	require.Equal(t, ""+
+		"::error file=acme/v1/user.proto,line=3,col=1,title=COMMENT_MESSAGE::User: message comments must not be empty\n"+
+		"::warning file=acme/v1/user.proto,line=4,col=3,title=COMMENT_FIELD::id: field comments must not be empty\n"+
+		"::notice file=acme/v1/order.proto,line=1,col=1,title=ACME_CUSTOM::custom: 100%25, really\n",
+		buf.String(),
+	)
+}
+
+func TestPrintIssues_GitLabCodeQuality(t *testing.T) {
+	t.Parallel()
+
+	issues := append(testIssues(), testIssues()[1])
+
+	var buf bytes.Buffer
+	require.NoError(t, printIssues(flags.GitLabCodeQualityFormat, &buf, issues, lintIssueReport()))
+
+	var got []gitlabCodeQualityIssue
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	require.Len(t, got, 4)
+	require.Equal(t, "COMMENT_FIELD", got[1].CheckName)
+	require.Equal(t, 4, got[1].Location.Lines.Begin)
+	require.Equal(t, []string{"major", "minor", "info"}, []string{got[0].Severity, got[1].Severity, got[2].Severity})
+	require.NotEqual(t, got[1].Fingerprint, got[3].Fingerprint, "fingerprints of the same issues have to be unique")
+
+	var again bytes.Buffer
+	require.NoError(t, printIssues(flags.GitLabCodeQualityFormat, &again, issues, lintIssueReport()))
+	require.Equal(t, buf.String(), again.String())
+}
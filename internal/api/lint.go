@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,6 +21,8 @@ import (
 
 var _ Handler = (*Lint)(nil)
 
+const defaultLintBaselinePath = "easyp-lint-baseline.json"
+
 // Lint is a handler for lint command.
 type Lint struct{}
 
@@ -42,6 +45,45 @@ var (
 		Aliases:    []string{"r"},
 	}
 
+	flagLintBaseline = &cli.StringFlag{
+		Name:      "baseline",
+		Usage:     "set path to baseline file with known issues, only new issues are reported (default: " + defaultLintBaselinePath + " if it exists)",
+		Required:  false,
+		TakesFile: true,
+	}
+
+	flagLintWriteBaseline = &cli.BoolFlag{
+		Name:     "write-baseline",
+		Usage:    "write current issues to baseline file (--baseline or " + defaultLintBaselinePath + ") instead of reporting them",
+		Required: false,
+	}
+
+	flagLintFailOn = &cli.GenericFlag{
+		Name:     "fail-on",
+		Usage:    "set minimal severity of issues which fail the command",
+		Required: false,
+		Value: &flags.EnumValue{
+			Enum: []string{
+				string(core.SeverityWarning),
+				string(core.SeverityError),
+			},
+			Default: string(core.SeverityError),
+		},
+	}
+
+	flagLintAgainst = &cli.StringFlag{
+		Name:     "against",
+		Usage:    "set git ref (branch, tag, commit) to compare with, only issues of changed files are reported",
+		Required: false,
+		Aliases:  []string{"new-from-rev"},
+	}
+
+	flagLintChangedLines = &cli.BoolFlag{
+		Name:     "changed-lines",
+		Usage:    "report only issues on lines changed against --against git ref",
+		Required: false,
+	}
+
 	ErrHasLintIssue     = errors.New("has lint issue")
 	ErrHasValidateIssue = errors.New("has validate issue")
 )
@@ -61,10 +103,16 @@ func (l Lint) Command() *cli.Command {
 		After:        nil,
 		Action:       l.Action,
 		OnUsageError: nil,
-		Subcommands:  nil,
+		Subcommands:  []*cli.Command{l.explainCommand()},
 		Flags: []cli.Flag{
 			flagLintDirectoryPath,
 			flagLintRoot,
+			flagLintBaseline,
+			flagLintWriteBaseline,
+			flagLintFailOn,
+			flagLintRules,
+			flagLintAgainst,
+			flagLintChangedLines,
 		},
 		SkipFlagParsing:        false,
 		HideHelp:               false,
@@ -83,12 +131,17 @@ func (l Lint) Action(ctx *cli.Context) error {
 	err := l.action(ctx, log)
 	if err != nil {
 		var e *core.OpenImportFileError
+		var g *core.GitRefNotFoundError
 
 		switch {
 		case errors.Is(err, ErrHasLintIssue):
 			os.Exit(1)
 		case errors.As(err, &e):
 			errExit(log, 2, "Cannot import file", slog.String("file name", e.FileName))
+		case errors.As(err, &g):
+			errExit(log, 2, "Cannot find git ref", slog.String("ref", g.GitRef))
+		case errors.Is(err, core.ErrRepositoryDoesNotExist):
+			errExit(log, 2, "Repository does not exist in current directory")
 		default:
 			return err
 		}
@@ -108,6 +161,15 @@ func (l Lint) action(ctx *cli.Context, log logger.Logger) error {
 		return fmt.Errorf("config.New: %w", err)
 	}
 
+	if ctx.Bool(flagLintRules.Name) {
+		lintRules, err := listLintRules(cfg.Lint)
+		if err != nil {
+			return fmt.Errorf("listLintRules: %w", err)
+		}
+
+		return printLintRules(flags.GetFormat(ctx, flags.TextFormat), os.Stdout, lintRules)
+	}
+
 	// Walker for Core (lockfile etc) - strictly based on project root
 	projectWalker := fs.NewFSWalker(projectRoot, ".")
 	app, err := buildCore(ctx.Context, log, *cfg, projectWalker)
@@ -119,33 +181,112 @@ func (l Lint) action(ctx *cli.Context, log logger.Logger) error {
 
 	// Walker for Linting - based on requested root and path
 	lintWalker := fs.NewFSWalker(lintRoot, path)
-	issues, err := app.Lint(ctx.Context, lintWalker)
-	if err != nil {
-		return fmt.Errorf("c.Lint: %w", err)
+
+	against := ctx.String(flagLintAgainst.Name)
+
+	// issues of unchanged files aren't reported, so they would be dropped from the baseline
+	if against != "" && ctx.Bool(flagLintWriteBaseline.Name) {
+		return fmt.Errorf("--%s can't be used with --%s", flagLintWriteBaseline.Name, flagLintAgainst.Name)
 	}
 
-	if len(issues) == 0 {
+	var issues []core.IssueInfo
+	if against != "" {
+		issues, err = app.LintChanges(
+			ctx.Context, lintWalker, lintRoot, path, against, ctx.Bool(flagLintChangedLines.Name),
+		)
+		if err != nil {
+			return fmt.Errorf("app.LintChanges: %w", err)
+		}
+	} else {
+		if ctx.Bool(flagLintChangedLines.Name) {
+			return fmt.Errorf("--%s requires --%s", flagLintChangedLines.Name, flagLintAgainst.Name)
+		}
+
+		issues, err = app.Lint(ctx.Context, lintWalker)
+		if err != nil {
+			return fmt.Errorf("c.Lint: %w", err)
+		}
+	}
+
+	// baseline is read from the same default path as it's written to
+	baselinePath := ctx.String(flagLintBaseline.Name)
+	if baselinePath == "" {
+		baselinePath = defaultLintBaselinePath
+	}
+
+	if ctx.Bool(flagLintWriteBaseline.Name) {
+		if err := writeLintBaseline(baselinePath, core.NewLintBaseline(issues)); err != nil {
+			return fmt.Errorf("writeLintBaseline: %w", err)
+		}
+
+		log.Info(ctx.Context, "lint baseline written",
+			slog.String("path", baselinePath),
+			slog.Int("issues", len(issues)),
+		)
+
 		return nil
 	}
 
+	baseline, err := readLintBaseline(baselinePath)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && !ctx.IsSet(flagLintBaseline.Name):
+		log.Debug(ctx.Context, "lint baseline is not found", slog.String("path", baselinePath))
+	case err != nil:
+		return fmt.Errorf("readLintBaseline: %w", err)
+	default:
+		var stale []core.LintBaselineIssue
+		issues, stale = baseline.Filter(issues)
+
+		// entries of unchanged files and lines don't match issues reported against git ref, but they aren't stale
+		if against == "" {
+			if err := printStaleLintBaseline(os.Stderr, baselinePath, stale); err != nil {
+				return fmt.Errorf("printStaleLintBaseline: %w", err)
+			}
+		}
+	}
+
 	format := flags.GetFormat(ctx, flags.TextFormat)
 	if err := printIssues(
 		format,
 		os.Stdout,
 		issues,
+		lintIssueReport(),
 	); err != nil {
 		return fmt.Errorf("printLintErrors: %w", err)
 	}
 
-	return ErrHasLintIssue
+	failOn, err := core.ParseSeverity(ctx.String(flagLintFailOn.Name))
+	if err != nil {
+		return fmt.Errorf("core.ParseSeverity: %w", err)
+	}
+
+	for _, issue := range issues {
+		if issueSeverity(issue).AtLeast(failOn) {
+			return ErrHasLintIssue
+		}
+	}
+
+	return nil
 }
 
-func printIssues(format string, w io.Writer, issues []core.IssueInfo) error {
+// printIssues prints issues in passed format.
+// Reports in CI formats are printed even without issues, so CI can clear previous results.
+func printIssues(format string, w io.Writer, issues []core.IssueInfo, report issueReport) error {
 	switch format {
 	case flags.TextFormat:
 		return textPrinter(w, issues)
 	case flags.JSONFormat:
 		return jsonPrinter(w, issues)
+	case flags.SARIFFormat:
+		return sarifPrinter(w, issues, report)
+	case flags.JUnitFormat:
+		return junitPrinter(w, issues, report)
+	case flags.CheckstyleFormat:
+		return checkstylePrinter(w, issues, report)
+	case flags.GitHubActionsFormat:
+		return githubActionsPrinter(w, issues)
+	case flags.GitLabCodeQualityFormat:
+		return gitlabCodeQualityPrinter(w, issues)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -157,13 +298,14 @@ func textPrinter(w io.Writer, issues []core.IssueInfo) error {
 	for _, issue := range issues {
 		buffer.Reset()
 
-		str := fmt.Sprintf("%s:%d:%d:%s %s (%s)",
+		str := fmt.Sprintf("%s:%d:%d:%s %s (%s) [%s]",
 			issue.Path,
 			issue.Position.Line,
 			issue.Position.Column,
 			issue.SourceName,
 			issue.Message,
 			issue.RuleName,
+			issueSeverity(issue),
 		)
 
 		_, _ = buffer.WriteString(str)
@@ -187,3 +329,60 @@ func jsonPrinter(w io.Writer, issues []core.IssueInfo) error {
 
 	return nil
 }
+
+// readLintBaseline reads lint baseline from json file.
+func readLintBaseline(path string) (core.LintBaseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return core.LintBaseline{}, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	var baseline core.LintBaseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return core.LintBaseline{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	if baseline.Version != core.LintBaselineVersion {
+		return core.LintBaseline{}, fmt.Errorf(
+			"unsupported lint baseline version: %d, rewrite it with --%s", baseline.Version, flagLintWriteBaseline.Name,
+		)
+	}
+
+	return baseline, nil
+}
+
+// printStaleLintBaseline prints baseline entries which don't match any issue anymore,
+// so they are noticed and removed by rewriting the baseline.
+func printStaleLintBaseline(w io.Writer, path string, stale []core.LintBaselineIssue) error {
+	if len(stale) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w, "%d stale entries in lint baseline %s, rewrite it with --%s:\n",
+		len(stale), path, flagLintWriteBaseline.Name); err != nil {
+		return fmt.Errorf("fmt.Fprintf: %w", err)
+	}
+
+	for _, issue := range stale {
+		element := cmp.Or(issue.Element, issue.SourceName)
+		if _, err := fmt.Fprintf(w, "\t%s: %s %s\n", issue.Path, issue.RuleName, element); err != nil {
+			return fmt.Errorf("fmt.Fprintf: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// writeLintBaseline writes lint baseline to json file.
+func writeLintBaseline(path string, baseline core.LintBaseline) error {
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return fmt.Errorf("json.MarshalIndent: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/samber/lo"
+	"github.com/urfave/cli/v2"
+
+	"github.com/easyp-tech/easyp/internal/config"
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+var flagLintRules = &cli.BoolFlag{
+	Name:     "rules",
+	Usage:    "list all lint rules with their groups, options and whether they are enabled by config",
+	Required: false,
+}
+
+// lintRule is a builtin lint rule with its state in config.
+type lintRule struct {
+	rules.RuleInfo
+	Enabled bool `json:"enabled"`
+}
+
+// explainCommand returns the command which explains a lint rule.
+func (l Lint) explainCommand() *cli.Command {
+	return &cli.Command{
+		Name:        "explain",
+		Usage:       "explain lint rule",
+		UsageText:   "explain RULE",
+		Description: "print description of lint rule with bad and good proto examples",
+		ArgsUsage:   "RULE",
+		Action:      l.Explain,
+	}
+}
+
+// Explain prints description of the lint rule.
+func (l Lint) Explain(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("expected exactly one rule name, got %d arguments", ctx.NArg())
+	}
+
+	// rule names can be passed as in docs: service-suffix
+	name := strings.ToUpper(strings.ReplaceAll(ctx.Args().First(), "-", "_"))
+
+	info, err := rules.RuleByName(name)
+	if err != nil {
+		return fmt.Errorf("rules.RuleByName: %w", err)
+	}
+
+	return printLintRuleExplain(flags.GetFormat(ctx, flags.TextFormat), os.Stdout, info)
+}
+
+// listLintRules returns all builtin lint rules, rules enabled by cfg are marked.
+func listLintRules(cfg config.LintConfig) ([]lintRule, error) {
+	enabled, _, err := rules.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("rules.New: %w", err)
+	}
+
+	enabledNames := lo.Map(enabled, func(rule core.Rule, _ int) string {
+		return core.GetRuleName(rule)
+	})
+
+	return lo.Map(rules.AllRules(), func(info rules.RuleInfo, _ int) lintRule {
+		return lintRule{
+			RuleInfo: info,
+			Enabled:  lo.Contains(enabledNames, info.Name),
+		}
+	}), nil
+}
+
+// printLintRules prints lint rules in passed format.
+func printLintRules(format string, w io.Writer, lintRules []lintRule) error {
+	switch format {
+	case flags.TextFormat:
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+		fmt.Fprintln(tw, "RULE\tGROUPS\tENABLED\tMESSAGE\tOPTIONS")
+		for _, rule := range lintRules {
+			fmt.Fprintf(tw, "%s\t%s\t%t\t%s\t%s\n",
+				rule.Name,
+				joinOrDash(rule.Groups),
+				rule.Enabled,
+				rule.Message,
+				// all options are in lint section, prefix only widens the table
+				joinOrDash(lo.Map(rule.Doc.Options, func(option string, _ int) string {
+					return strings.TrimPrefix(option, "lint.")
+				})),
+			)
+		}
+
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("tw.Flush: %w", err)
+		}
+
+		return nil
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(lintRules); err != nil {
+			return fmt.Errorf("enc.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// printLintRuleExplain prints description of the lint rule in passed format.
+func printLintRuleExplain(format string, w io.Writer, info rules.RuleInfo) error {
+	switch format {
+	case flags.TextFormat:
+		var b strings.Builder
+
+		fmt.Fprintf(&b, "%s\n\n", info.Name)
+		fmt.Fprintf(&b, "Groups:  %s\n", joinOrDash(info.Groups))
+		fmt.Fprintf(&b, "Options: %s\n", joinOrDash(info.Doc.Options))
+		fmt.Fprintf(&b, "Message: %s\n\n", info.Message)
+		fmt.Fprintf(&b, "%s\n\n", info.Doc.Description)
+		fmt.Fprintf(&b, "Bad:\n\n%s\n", indent(info.Doc.Bad))
+		fmt.Fprintf(&b, "Good:\n\n%s", indent(info.Doc.Good))
+
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return fmt.Errorf("io.WriteString: %w", err)
+		}
+
+		return nil
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(info); err != nil {
+			return fmt.Errorf("enc.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+
+	return strings.Join(values, ",")
+}
+
+// indent indents every non-empty line of the text.
+func indent(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "    " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
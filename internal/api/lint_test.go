@@ -0,0 +1,41 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+func TestPrintStaleLintBaseline(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		stale []core.LintBaselineIssue
+		want  string
+	}{
+		"no stale entries": {},
+		"stale entries": {
+			stale: []core.LintBaselineIssue{
+				{Path: "a.proto", RuleName: "COMMENT_FIELD", Element: "foo.User.id", SourceName: "id"},
+				{Path: "b.proto", RuleName: "IMPORT_USED", SourceName: `"bar.proto"`},
+			},
+			want: "" +
+				"2 stale entries in lint baseline easyp-lint-baseline.json, rewrite it with --write-baseline:\n" +
+				"\ta.proto: COMMENT_FIELD foo.User.id\n" +
+				"\tb.proto: IMPORT_USED \"bar.proto\"\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			require.NoError(t, printStaleLintBaseline(&buf, defaultLintBaselinePath, tc.stale))
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
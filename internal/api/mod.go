@@ -7,6 +7,7 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/easyp-tech/easyp/internal/core"
 	"github.com/easyp-tech/easyp/internal/core/models"
 	"github.com/easyp-tech/easyp/internal/flags"
 	"github.com/easyp-tech/easyp/internal/fs/fs"
@@ -42,6 +43,56 @@ func (m Mod) Command() *cli.Command {
 		Action:      m.Vendor,
 	}
 
+	graphCmd := &cli.Command{
+		Name:        "graph",
+		Usage:       "print modules requirement graph",
+		UsageText:   "graph [--format text|dot|json]",
+		Description: "print every required module version with direct and indirect requirements",
+		Action:      m.Graph,
+	}
+	whyCmd := &cli.Command{
+		Name:        "why",
+		Usage:       "show why modules are needed",
+		UsageText:   "why <module> [<module>...]",
+		Description: "show the shortest import chain from a workspace proto file to a proto file of the module",
+		Action:      m.Why,
+	}
+
+	tidyCmd := &cli.Command{
+		Name:        "tidy",
+		Usage:       "remove unused modules and add imported indirect ones",
+		UsageText:   "tidy",
+		Description: "remove modules not imported by workspace protos from deps and lock file, add indirect modules imported by workspace to deps",
+		Action:      m.Tidy,
+	}
+
+	getCmd := &cli.Command{
+		Name:        "get",
+		Aliases:     []string{"add"},
+		Usage:       "add modules to deps or change their versions",
+		UsageText:   "get [--yes] <module>[@<version>|@latest|@<branch>] [<module>...]",
+		Description: "resolve modules versions, install modules and update deps in config and lock file",
+		Action:      m.Get,
+		Flags:       []cli.Flag{flagModGetYes},
+	}
+	removeCmd := &cli.Command{
+		Name:        "remove",
+		Aliases:     []string{"rm"},
+		Usage:       "remove modules from deps",
+		UsageText:   "remove <module> [<module>...]",
+		Description: "remove modules from deps in config and lock file with their dependencies which are not required anymore",
+		Action:      m.Remove,
+	}
+
+	outdatedCmd := &cli.Command{
+		Name:        "outdated",
+		Usage:       "show newer versions of modules",
+		UsageText:   "outdated [--breaking] [--format text|json]",
+		Description: "list release tags newer than locked versions of modules classified as patch, minor or major updates",
+		Action:      m.Outdated,
+		Flags:       []cli.Flag{flagModOutdatedBreaking},
+	}
+
 	return &cli.Command{
 		Name:                   "mod",
 		Aliases:                []string{"m"},
@@ -55,7 +106,7 @@ func (m Mod) Command() *cli.Command {
 		After:                  nil,
 		Action:                 nil,
 		OnUsageError:           nil,
-		Subcommands:            []*cli.Command{downloadCmd, updateCmd, vendorCmd},
+		Subcommands:            []*cli.Command{downloadCmd, updateCmd, vendorCmd, graphCmd, whyCmd, tidyCmd, getCmd, removeCmd, outdatedCmd},
 		Flags:                  []cli.Flag{},
 		SkipFlagParsing:        false,
 		HideHelp:               false,
@@ -87,6 +138,8 @@ func (m Mod) Download(ctx *cli.Context) error {
 	}
 
 	if err := app.Download(ctx.Context); err != nil {
+		exitOnDependencyConflict(err)
+
 		if errors.Is(err, models.ErrVersionNotFound) {
 			os.Exit(1)
 		}
@@ -116,6 +169,8 @@ func (m Mod) Update(ctx *cli.Context) error {
 	}
 
 	if err := app.Update(ctx.Context); err != nil {
+		exitOnDependencyConflict(err)
+
 		if errors.Is(err, models.ErrVersionNotFound) {
 			os.Exit(1)
 		}
@@ -145,6 +200,8 @@ func (m Mod) Vendor(ctx *cli.Context) error {
 	}
 
 	if err := app.Vendor(ctx.Context); err != nil {
+		exitOnDependencyConflict(err)
+
 		if errors.Is(err, models.ErrVersionNotFound) {
 			os.Exit(1)
 		}
@@ -154,6 +211,15 @@ func (m Mod) Vendor(ctx *cli.Context) error {
 	return nil
 }
 
+// exitOnDependencyConflict prints conflicts report and exits if versions of dependencies can't be resolved.
+func exitOnDependencyConflict(err error) {
+	var conflictErr *core.DependencyConflictError
+	if errors.As(err, &conflictErr) {
+		_, _ = fmt.Fprintln(os.Stderr, conflictErr.Error())
+		os.Exit(1)
+	}
+}
+
 func getDepsFromGenerateDeps(cfg config.Generate) []string {
 	res := make([]string, 0, len(cfg.Inputs))
 	for _, input := range cfg.Inputs {
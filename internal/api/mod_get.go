@@ -0,0 +1,140 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/easyp-tech/easyp/internal/adapters/prompter"
+	"github.com/easyp-tech/easyp/internal/config"
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+var flagModGetYes = &cli.BoolFlag{
+	Name:     "yes",
+	Usage:    "pin versions resolved from latest or a branch without confirmation",
+	Required: false,
+	Aliases:  []string{"y"},
+}
+
+func (m Mod) Get(ctx *cli.Context) error {
+	log := getLogger(ctx)
+
+	if ctx.NArg() == 0 {
+		return ErrModuleRequired
+	}
+
+	configPath, projectRoot, _, err := resolveRoots(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.New(ctx.Context, configPath)
+	if err != nil {
+		return fmt.Errorf("config.New: %w", err)
+	}
+
+	dirWalker := fs.NewFSWalker(projectRoot, ".")
+	app, err := buildCore(ctx.Context, log, *cfg, dirWalker)
+	if err != nil {
+		return fmt.Errorf("buildCore: %w", err)
+	}
+
+	modules := make([]models.Module, 0, ctx.NArg())
+	for _, dep := range ctx.Args().Slice() {
+		modules = append(modules, models.NewModule(dep))
+	}
+
+	opts := core.GetOptions{Prompter: prompter.InteractivePrompter{}}
+	if ctx.Bool(flagModGetYes.Name) {
+		opts.Prompter = nil
+	}
+
+	pinned, err := app.Get(ctx.Context, modules, opts)
+	if err != nil {
+		exitOnDependencyConflict(err)
+
+		if errors.Is(err, core.ErrGetCanceled) {
+			return nil
+		}
+
+		return fmt.Errorf("app.Get: %w", err)
+	}
+
+	if err := config.UpdateDeps(configPath, getDeps(cfg.Deps, pinned)); err != nil {
+		return fmt.Errorf("config.UpdateDeps: %w", err)
+	}
+
+	return nil
+}
+
+func (m Mod) Remove(ctx *cli.Context) error {
+	log := getLogger(ctx)
+
+	if ctx.NArg() == 0 {
+		return ErrModuleRequired
+	}
+
+	configPath, projectRoot, _, err := resolveRoots(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.New(ctx.Context, configPath)
+	if err != nil {
+		return fmt.Errorf("config.New: %w", err)
+	}
+
+	dirWalker := fs.NewFSWalker(projectRoot, ".")
+	app, err := buildCore(ctx.Context, log, *cfg, dirWalker)
+	if err != nil {
+		return fmt.Errorf("buildCore: %w", err)
+	}
+
+	moduleNames := make([]string, 0, ctx.NArg())
+	for _, dep := range ctx.Args().Slice() {
+		moduleNames = append(moduleNames, models.NewModule(dep).Name)
+	}
+
+	if err := app.Remove(ctx.Context, moduleNames); err != nil {
+		exitOnDependencyConflict(err)
+
+		return fmt.Errorf("app.Remove: %w", err)
+	}
+
+	if err := config.UpdateDeps(configPath, removeDeps(cfg.Deps, moduleNames)); err != nil {
+		return fmt.Errorf("config.UpdateDeps: %w", err)
+	}
+
+	return nil
+}
+
+// getDeps sets versions of modules in deps from config, new modules are appended.
+func getDeps(deps []string, modules []models.Module) []string {
+	res := slices.Clone(deps)
+
+	for _, module := range modules {
+		dep := module.Name + "@" + string(module.Version)
+
+		i := slices.IndexFunc(res, func(d string) bool { return models.NewModule(d).Name == module.Name })
+		if i < 0 {
+			res = append(res, dep)
+			continue
+		}
+
+		res[i] = dep
+	}
+
+	return res
+}
+
+// removeDeps removes modules from deps from config.
+func removeDeps(deps []string, moduleNames []string) []string {
+	return slices.DeleteFunc(slices.Clone(deps), func(dep string) bool {
+		return slices.Contains(moduleNames, models.NewModule(dep).Name)
+	})
+}
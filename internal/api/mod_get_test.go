@@ -0,0 +1,41 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+func TestGetDeps(t *testing.T) {
+	t.Parallel()
+
+	got := getDeps([]string{
+		"github.com/googleapis/googleapis@v1.1.0",
+		"github.com/bufbuild/protovalidate",
+	}, []models.Module{
+		{Name: "github.com/bufbuild/protovalidate", Version: "v0.8.0"},
+		{Name: "github.com/acme/api", Version: "v0.0.0-20250101000000-a1"},
+	})
+
+	require.Equal(t, []string{
+		"github.com/googleapis/googleapis@v1.1.0",
+		"github.com/bufbuild/protovalidate@v0.8.0",
+		"github.com/acme/api@v0.0.0-20250101000000-a1",
+	}, got)
+}
+
+func TestRemoveDeps(t *testing.T) {
+	t.Parallel()
+
+	deps := []string{
+		"github.com/googleapis/googleapis@v1.1.0",
+		"github.com/bufbuild/protovalidate@v0.8.0",
+	}
+
+	got := removeDeps(deps, []string{"github.com/googleapis/googleapis"})
+
+	require.Equal(t, []string{"github.com/bufbuild/protovalidate@v0.8.0"}, got)
+	require.Len(t, deps, 2)
+}
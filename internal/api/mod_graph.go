@@ -0,0 +1,171 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/easyp-tech/easyp/internal/config"
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+var ErrModuleRequired = errors.New("module is required")
+
+func (m Mod) Graph(ctx *cli.Context) error {
+	log := getLogger(ctx)
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("os.Getwd: %w", err)
+	}
+	dirWalker := fs.NewFSWalker(workingDir, ".")
+
+	cfg, err := config.New(ctx.Context, ctx.String(flags.Config.Name))
+	if err != nil {
+		return fmt.Errorf("config.New: %w", err)
+	}
+
+	app, err := buildCore(ctx.Context, log, *cfg, dirWalker)
+	if err != nil {
+		return fmt.Errorf("buildCore: %w", err)
+	}
+
+	graph, err := app.Graph(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("app.Graph: %w", err)
+	}
+
+	if err := printModuleGraph(flags.GetFormat(ctx, flags.TextFormat), os.Stdout, graph); err != nil {
+		return fmt.Errorf("printModuleGraph: %w", err)
+	}
+
+	return nil
+}
+
+func (m Mod) Why(ctx *cli.Context) error {
+	log := getLogger(ctx)
+
+	if ctx.NArg() == 0 {
+		return ErrModuleRequired
+	}
+
+	configPath, projectRoot, opRoot, err := resolveRoots(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.New(ctx.Context, configPath)
+	if err != nil {
+		return fmt.Errorf("config.New: %w", err)
+	}
+
+	dirWalker := fs.NewFSWalker(projectRoot, ".")
+	app, err := buildCore(ctx.Context, log, *cfg, dirWalker)
+	if err != nil {
+		return fmt.Errorf("buildCore: %w", err)
+	}
+
+	whys := make([]core.ModuleWhy, 0, ctx.NArg())
+	for _, module := range ctx.Args().Slice() {
+		why, err := app.Why(ctx.Context, opRoot, module)
+		if err != nil {
+			return fmt.Errorf("app.Why: %s: %w", module, err)
+		}
+
+		whys = append(whys, why)
+	}
+
+	if err := printModuleWhy(flags.GetFormat(ctx, flags.TextFormat), os.Stdout, whys); err != nil {
+		return fmt.Errorf("printModuleWhy: %w", err)
+	}
+
+	return nil
+}
+
+// printModuleGraph prints requirements graph in text, dot or json format.
+// Text format is like go mod graph: a requirement per line, indirect ones are marked.
+func printModuleGraph(format string, w io.Writer, graph core.ModuleGraph) error {
+	switch format {
+	case flags.TextFormat:
+		b := &strings.Builder{}
+		for _, edge := range graph.Edges {
+			b.WriteString(edge.From + " " + edge.To)
+			if edge.Indirect {
+				b.WriteString(" // indirect")
+			}
+			b.WriteString("\n")
+		}
+
+		return writeString(w, b.String())
+	case flags.DOTFormat:
+		b := &strings.Builder{}
+		b.WriteString("digraph modules {\n")
+		fmt.Fprintf(b, "  %q [shape=box];\n", graph.Root)
+		for _, node := range graph.Nodes {
+			if node.Indirect {
+				fmt.Fprintf(b, "  %q [style=dashed];\n", node.String())
+			}
+		}
+		for _, edge := range graph.Edges {
+			if edge.Indirect {
+				fmt.Fprintf(b, "  %q -> %q [style=dashed];\n", edge.From, edge.To)
+				continue
+			}
+			fmt.Fprintf(b, "  %q -> %q;\n", edge.From, edge.To)
+		}
+		b.WriteString("}\n")
+
+		return writeString(w, b.String())
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(graph); err != nil {
+			return fmt.Errorf("json.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// printModuleWhy prints import chains in text or json format.
+func printModuleWhy(format string, w io.Writer, whys []core.ModuleWhy) error {
+	switch format {
+	case flags.TextFormat:
+		b := &strings.Builder{}
+		for i, why := range whys {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+
+			b.WriteString("# " + why.Module + "\n")
+			if len(why.Imports) == 0 {
+				b.WriteString("(workspace does not import any proto file from " + why.Module + ")\n")
+				continue
+			}
+			for _, importPath := range why.Imports {
+				b.WriteString(importPath + "\n")
+			}
+		}
+
+		return writeString(w, b.String())
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(whys); err != nil {
+			return fmt.Errorf("json.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+func TestPrintModuleGraph(t *testing.T) {
+	t.Parallel()
+
+	graph := core.ModuleGraph{
+		Root: "easyp.yaml",
+		Nodes: []core.ModuleGraphNode{
+			{Name: "github.com/acme/api", Version: "v1.0.0"},
+			{Name: "github.com/googleapis/googleapis", Version: "v1.3.0", Indirect: true},
+		},
+		Edges: []core.ModuleGraphEdge{
+			{From: "easyp.yaml", To: "github.com/acme/api@v1.0.0"},
+			{From: "github.com/acme/api@v1.0.0", To: "github.com/googleapis/googleapis@v1.3.0", Indirect: true},
+		},
+	}
+
+	tests := map[string]struct {
+		format string
+		want   string
+	}{
+		"text": {
+			format: flags.TextFormat,
+			want: "easyp.yaml github.com/acme/api@v1.0.0\n" +
+				"github.com/acme/api@v1.0.0 github.com/googleapis/googleapis@v1.3.0 // indirect\n",
+		},
+		"dot": {
+			format: flags.DOTFormat,
+			want: "digraph modules {\n" +
+				"  \"easyp.yaml\" [shape=box];\n" +
+				"  \"github.com/googleapis/googleapis@v1.3.0\" [style=dashed];\n" +
+				"  \"easyp.yaml\" -> \"github.com/acme/api@v1.0.0\";\n" +
+				"  \"github.com/acme/api@v1.0.0\" -> \"github.com/googleapis/googleapis@v1.3.0\" [style=dashed];\n" +
+				"}\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			require.NoError(t, printModuleGraph(tc.format, &buf, graph))
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestPrintModuleWhy_Text(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printModuleWhy(flags.TextFormat, &buf, []core.ModuleWhy{
+		{
+			Module:  "github.com/googleapis/googleapis",
+			Imports: []string{"api/v1/service.proto", "google/api/annotations.proto"},
+		},
+		{
+			Module:  "github.com/acme/unused",
+			Imports: []string{},
+		},
+	}))
+
+	want := "# github.com/googleapis/googleapis\n" +
+		"api/v1/service.proto\n" +
+		"google/api/annotations.proto\n" +
+		"\n" +
+		"# github.com/acme/unused\n" +
+		"(workspace does not import any proto file from github.com/acme/unused)\n"
+	require.Equal(t, want, buf.String())
+}
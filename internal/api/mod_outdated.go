@@ -0,0 +1,121 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/easyp-tech/easyp/internal/config"
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+var flagModOutdatedBreaking = &cli.BoolFlag{
+	Name:     "breaking",
+	Usage:    "run breaking check of the latest version against the locked one",
+	Required: false,
+}
+
+func (m Mod) Outdated(ctx *cli.Context) error {
+	log := getLogger(ctx)
+
+	configPath, projectRoot, _, err := resolveRoots(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.New(ctx.Context, configPath)
+	if err != nil {
+		return fmt.Errorf("config.New: %w", err)
+	}
+
+	dirWalker := fs.NewFSWalker(projectRoot, ".")
+	app, err := buildCore(ctx.Context, log, *cfg, dirWalker)
+	if err != nil {
+		return fmt.Errorf("buildCore: %w", err)
+	}
+
+	modules, err := app.Outdated(ctx.Context, core.OutdatedOptions{
+		Breaking: ctx.Bool(flagModOutdatedBreaking.Name),
+	})
+	if err != nil {
+		exitOnDependencyConflict(err)
+
+		return fmt.Errorf("app.Outdated: %w", err)
+	}
+
+	if err := printOutdated(flags.GetFormat(ctx, flags.TextFormat), os.Stdout, modules); err != nil {
+		return fmt.Errorf("printOutdated: %w", err)
+	}
+
+	return nil
+}
+
+// printOutdated prints outdated modules as a table or json.
+// Table contains the latest update of every kind, breaking column is printed only if breaking check was run.
+func printOutdated(format string, w io.Writer, modules []core.OutdatedModule) error {
+	switch format {
+	case flags.TextFormat:
+		breaking := slices.ContainsFunc(modules, func(module core.OutdatedModule) bool {
+			return module.Breaking != nil
+		})
+
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+		header := "MODULE\tCURRENT\tPATCH\tMINOR\tMAJOR\tINDIRECT"
+		if breaking {
+			header += "\tBREAKING"
+		}
+		fmt.Fprintln(tw, header)
+
+		for _, module := range modules {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%t",
+				module.Name,
+				module.Version,
+				valueOrDash(module.Latest(core.UpdatePatch)),
+				valueOrDash(module.Latest(core.UpdateMinor)),
+				valueOrDash(module.Latest(core.UpdateMajor)),
+				module.Indirect,
+			)
+			if breaking {
+				issues := "-"
+				if module.Breaking != nil {
+					issues = strconv.Itoa(len(module.Breaking.Issues))
+				}
+				fmt.Fprintf(tw, "\t%s", issues)
+			}
+			fmt.Fprintln(tw)
+		}
+
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("tw.Flush: %w", err)
+		}
+
+		return nil
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(modules); err != nil {
+			return fmt.Errorf("json.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+func TestPrintOutdated_Text(t *testing.T) {
+	t.Parallel()
+
+	modules := []core.OutdatedModule{
+		{
+			Name:    "github.com/bufbuild/protovalidate",
+			Version: "v0.8.0",
+			Updates: []core.ModuleUpdate{
+				{Version: "v0.8.1", Kind: core.UpdatePatch},
+				{Version: "v0.9.0", Kind: core.UpdateMinor},
+			},
+		},
+		{
+			Name:     "github.com/googleapis/googleapis",
+			Version:  "v1.3.0",
+			Indirect: true,
+			Updates:  []core.ModuleUpdate{{Version: "v2.0.0", Kind: core.UpdateMajor}},
+		},
+	}
+
+	tests := map[string]struct {
+		breaking []*core.OutdatedBreaking
+		want     string
+	}{
+		"without breaking check": {
+			want: "" +
+				"MODULE                             CURRENT  PATCH   MINOR   MAJOR   INDIRECT\n" +
+				"github.com/bufbuild/protovalidate  v0.8.0   v0.8.1  v0.9.0  -       false\n" +
+				"github.com/googleapis/googleapis   v1.3.0   -       -       v2.0.0  true\n",
+		},
+		"with breaking check": {
+			breaking: []*core.OutdatedBreaking{
+				{Version: "v0.9.0"},
+				{Version: "v2.0.0", Issues: []core.IssueInfo{{Path: "google/api/http.proto"}}},
+			},
+			want: "" +
+				"MODULE                             CURRENT  PATCH   MINOR   MAJOR   INDIRECT  BREAKING\n" +
+				"github.com/bufbuild/protovalidate  v0.8.0   v0.8.1  v0.9.0  -       false     0\n" +
+				"github.com/googleapis/googleapis   v1.3.0   -       -       v2.0.0  true      1\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make([]core.OutdatedModule, len(modules))
+			copy(got, modules)
+			for i, breaking := range tc.breaking {
+				got[i].Breaking = breaking
+			}
+
+			var buf bytes.Buffer
+			require.NoError(t, printOutdated(flags.TextFormat, &buf, got))
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestPrintOutdated_JSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printOutdated(flags.JSONFormat, &buf, []core.OutdatedModule{{
+		Name:    "github.com/bufbuild/protovalidate",
+		Version: "v0.8.0",
+		Updates: []core.ModuleUpdate{{Version: "v0.8.1", Kind: core.UpdatePatch}},
+	}}))
+
+	require.JSONEq(t, `[{
+		"name": "github.com/bufbuild/protovalidate",
+		"version": "v0.8.0",
+		"indirect": false,
+		"updates": [{"version": "v0.8.1", "kind": "patch"}]
+	}]`, buf.String())
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/easyp-tech/easyp/internal/config"
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/flags"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+func (m Mod) Tidy(ctx *cli.Context) error {
+	log := getLogger(ctx)
+
+	configPath, projectRoot, opRoot, err := resolveRoots(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.New(ctx.Context, configPath)
+	if err != nil {
+		return fmt.Errorf("config.New: %w", err)
+	}
+
+	dirWalker := fs.NewFSWalker(projectRoot, ".")
+	app, err := buildCore(ctx.Context, log, *cfg, dirWalker)
+	if err != nil {
+		return fmt.Errorf("buildCore: %w", err)
+	}
+
+	res, err := app.Tidy(ctx.Context, opRoot)
+	if err != nil {
+		exitOnDependencyConflict(err)
+
+		return fmt.Errorf("app.Tidy: %w", err)
+	}
+
+	if err := config.UpdateDeps(configPath, tidyDeps(cfg.Deps, res)); err != nil {
+		return fmt.Errorf("config.UpdateDeps: %w", err)
+	}
+
+	if err := printTidyResult(flags.GetFormat(ctx, flags.TextFormat), os.Stdout, res); err != nil {
+		return fmt.Errorf("printTidyResult: %w", err)
+	}
+
+	return nil
+}
+
+// tidyDeps applies tidy result to deps from config.
+func tidyDeps(deps []string, res core.TidyResult) []string {
+	tidied := make([]string, 0, len(deps)+len(res.Added))
+	for _, dep := range deps {
+		if slices.Contains(res.Removed, models.NewModule(dep).Name) {
+			continue
+		}
+
+		tidied = append(tidied, dep)
+	}
+
+	return append(tidied, res.Added...)
+}
+
+// printTidyResult prints removed and added modules in text or json format.
+func printTidyResult(format string, w io.Writer, res core.TidyResult) error {
+	switch format {
+	case flags.TextFormat:
+		b := &strings.Builder{}
+		for _, module := range res.Removed {
+			b.WriteString("removed " + module + "\n")
+		}
+		for _, module := range res.Added {
+			b.WriteString("added " + module + "\n")
+		}
+
+		return writeString(w, b.String())
+	case flags.JSONFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(res); err != nil {
+			return fmt.Errorf("json.Encode: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
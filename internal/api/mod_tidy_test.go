@@ -0,0 +1,42 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/flags"
+)
+
+func TestTidyDeps(t *testing.T) {
+	t.Parallel()
+
+	res := core.TidyResult{
+		Removed: []string{"github.com/acme/unused"},
+		Added:   []string{"github.com/googleapis/googleapis@v1.3.0"},
+	}
+
+	got := tidyDeps([]string{
+		"github.com/acme/unused@v0.1.0",
+		"github.com/bufbuild/protovalidate@v0.8.0",
+	}, res)
+
+	require.Equal(t, []string{
+		"github.com/bufbuild/protovalidate@v0.8.0",
+		"github.com/googleapis/googleapis@v1.3.0",
+	}, got)
+}
+
+func TestPrintTidyResult_Text(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, printTidyResult(flags.TextFormat, &buf, core.TidyResult{
+		Removed: []string{"github.com/acme/unused"},
+		Added:   []string{"github.com/googleapis/googleapis@v1.3.0"},
+	}))
+
+	require.Equal(t, "removed github.com/acme/unused\nadded github.com/googleapis/googleapis@v1.3.0\n", buf.String())
+}
@@ -68,17 +68,29 @@ func getEasypPath(log logger.Logger) (string, error) {
 	return easypPath, nil
 }
 
-func buildCore(_ context.Context, log logger.Logger, cfg config.Config, dirWalker core.DirWalker) (*core.Core, error) {
+func buildCore(ctx context.Context, log logger.Logger, cfg config.Config, dirWalker core.DirWalker) (*core.Core, error) {
 	vendorPath := defaultVendorDir // TODO: read from config
 
-	// Centralized: set once for all commands (lint, breaking, generate, mod).
-	core.SetAllowCommentIgnores(cfg.Lint.AllowCommentIgnores)
-
 	lintRules, ignoreOnly, err := rules.New(cfg.Lint)
 	if err != nil {
 		return nil, fmt.Errorf("cfg.BuildLinterRules: %w", err)
 	}
 
+	lintOverrides, err := rules.Overrides(cfg.Lint, lintRules)
+	if err != nil {
+		return nil, fmt.Errorf("rules.Overrides: %w", err)
+	}
+
+	severity, unknownRules, err := rules.Severity(cfg.Lint)
+	if err != nil {
+		return nil, fmt.Errorf("rules.Severity: %w", err)
+	}
+
+	for _, ruleName := range unknownRules {
+		log.Warn(ctx, "unknown rule in lint severity, it's expected to be reported by lint plugins",
+			slog.String("rule", ruleName))
+	}
+
 	lockFile := lockfile.New(dirWalker)
 	easypPath, err := getEasypPath(log)
 	if err != nil {
@@ -95,9 +107,24 @@ func buildCore(_ context.Context, log logger.Logger, cfg config.Config, dirWalke
 	linterIgnoreDirs := append(cfg.Lint.Ignore, vendorPath)
 	breakingCheckIgnoreDirs := append(cfg.BreakingCheck.Ignore, vendorPath)
 
+	breakingRules, err := core.ResolveBreakingRules(cfg.BreakingCheck.Use, cfg.BreakingCheck.Except)
+	if err != nil {
+		return nil, fmt.Errorf("core.ResolveBreakingRules: %w", err)
+	}
+
+	breakingIgnoreOnly, err := core.UnwrapBreakingIgnoreOnly(cfg.BreakingCheck.IgnoreOnly)
+	if err != nil {
+		return nil, fmt.Errorf("core.UnwrapBreakingIgnoreOnly: %w", err)
+	}
+
 	breakingCheckConfig := core.BreakingCheckConfig{
-		IgnoreDirs:    breakingCheckIgnoreDirs,
-		AgainstGitRef: cfg.BreakingCheck.AgainstGitRef,
+		IgnoreDirs:             breakingCheckIgnoreDirs,
+		AgainstGitRef:          cfg.BreakingCheck.AgainstGitRef,
+		AgainstModule:          cfg.BreakingCheck.AgainstModule,
+		AgainstInput:           cfg.BreakingCheck.AgainstInput,
+		Rules:                  breakingRules,
+		IgnoreOnly:             breakingIgnoreOnly,
+		IgnoreUnstablePackages: cfg.BreakingCheck.IgnoreUnstablePackages,
 	}
 
 	// Convert managed mode configuration
@@ -110,9 +137,24 @@ func buildCore(_ context.Context, log logger.Logger, cfg config.Config, dirWalke
 
 	app := core.New(
 		lintRules,
+		lintOverrides,
 		linterIgnoreDirs,
 		deps,
 		ignoreOnly,
+		severity,
+		core.CommentIgnoresConfig{
+			Allow:                cfg.Lint.AllowCommentIgnores,
+			RequireJustification: cfg.Lint.CommentIgnoresRequireJustification,
+			Groups:               rules.GroupRules(),
+		},
+		lo.Map(cfg.Lint.Plugins, func(p config.LintPlugin, _ int) core.LintPlugin {
+			return core.LintPlugin{
+				Path:    p.Path,
+				Command: p.Command,
+				Wasm:    p.Wasm,
+				Options: p.Opts,
+			}
+		}),
 		log,
 		lo.Map(cfg.Generate.Plugins, func(p config.Plugin, _ int) core.Plugin {
 			return core.Plugin{
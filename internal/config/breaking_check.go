@@ -2,7 +2,20 @@ package config
 
 // BreakingCheck is the configuration for `breaking` command
 type BreakingCheck struct {
+	// Use contains breaking rules and categories (FILE, PACKAGE, WIRE_JSON, WIRE) to check, FILE is used by default.
+	Use []string `json:"use,omitempty" yaml:"use,omitempty"`
+	// Except contains breaking rules and categories which should not be checked.
+	Except []string `json:"except,omitempty" yaml:"except,omitempty"`
 	Ignore []string `json:"ignore,omitempty" yaml:"ignore,omitempty"`
+	// IgnoreOnly contains files or dirs which should be ignored by rule or category.
+	IgnoreOnly map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
+	// IgnoreUnstablePackages disables checks of alpha, beta and test packages, e.g. foo.v1beta1.
+	IgnoreUnstablePackages bool `json:"ignore_unstable_packages,omitempty" yaml:"ignore_unstable_packages,omitempty"`
 	// git ref to compare with
 	AgainstGitRef string `json:"against_git_ref,omitempty" yaml:"against_git_ref,omitempty"`
+	// AgainstModule is a module version to compare with instead of git ref, e.g. github.com/acme/apis@v1.4.0.
+	AgainstModule string `json:"against_module,omitempty" yaml:"against_module,omitempty"`
+	// AgainstInput is a directory, an archive (.tar.gz, .tgz, .zip) or a descriptor set (.binpb, .pb, .bin)
+	// to compare with instead of git ref.
+	AgainstInput string `json:"against_input,omitempty" yaml:"against_input,omitempty"`
 }
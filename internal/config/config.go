@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/a8m/envsubst"
 	"gopkg.in/yaml.v3"
@@ -182,8 +183,8 @@ type Config struct {
 var errFileNotFound = errors.New("config file not found")
 
 // New creates a new configuration from the file.
-func New(_ context.Context, filepath string) (*Config, error) {
-	cfgFile, err := os.Open(filepath)
+func New(_ context.Context, configPath string) (*Config, error) {
+	cfgFile, err := os.Open(configPath)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, errFileNotFound
@@ -201,7 +202,24 @@ func New(_ context.Context, filepath string) (*Config, error) {
 		return nil, fmt.Errorf("io.ReadAll: %w", err)
 	}
 
-	return ParseConfig(buf)
+	cfg, err := ParseConfig(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.resolvePaths(filepath.Dir(configPath))
+
+	return cfg, nil
+}
+
+// resolvePaths makes paths of local files relative to the config file directory,
+// so they don't depend on the working directory.
+func (c *Config) resolvePaths(configDir string) {
+	for i, plugin := range c.Lint.Plugins {
+		if plugin.Wasm != "" && !filepath.IsAbs(plugin.Wasm) {
+			c.Lint.Plugins[i].Wasm = filepath.Join(configDir, plugin.Wasm)
+		}
+	}
 }
 
 // ParseConfig parses configuration from bytes with environment variable expansion.
@@ -288,6 +306,20 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate lint plugins
+	for i, plugin := range c.Lint.Plugins {
+		if err := plugin.Validate(); err != nil {
+			return fmt.Errorf("lint plugin %d: %w", i, err)
+		}
+	}
+
+	// Validate lint overrides
+	for i, override := range c.Lint.Overrides {
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("lint override %d: %w", i, err)
+		}
+	}
+
 	// Validate managed mode
 	if err := c.Generate.Managed.Validate(); err != nil {
 		return fmt.Errorf("managed mode validation: %w", err)
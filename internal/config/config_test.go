@@ -1,7 +1,9 @@
 package config
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -232,3 +234,23 @@ generate:
 		})
 	}
 }
+
+func TestNew_ResolvesLintPluginWasmPath(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "easyp.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`lint:
+  plugins:
+    - wasm: plugins/acme.wasm
+    - wasm: /opt/plugins/acme.wasm
+    - command: ["acme-lint"]
+`), 0o600))
+
+	cfg, err := New(context.Background(), configPath)
+	require.NoError(t, err)
+
+	require.Equal(t, filepath.Join(dir, "plugins", "acme.wasm"), cfg.Lint.Plugins[0].Wasm)
+	require.Equal(t, "/opt/plugins/acme.wasm", cfg.Lint.Plugins[1].Wasm)
+	require.Empty(t, cfg.Lint.Plugins[2].Wasm)
+}
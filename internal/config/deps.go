@@ -0,0 +1,147 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/a8m/envsubst"
+	"gopkg.in/yaml.v3"
+)
+
+const depsKey = "deps"
+
+// UpdateDeps rewrites deps section of the config file.
+// The file is edited as yaml nodes, so comments and other sections are kept.
+// Existing entries are matched by module name: entries that are not changed stay as is
+// (including environment variables), changed ones get the new value.
+func UpdateDeps(configPath string, deps []string) error {
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return fmt.Errorf("os.Stat: %w", err)
+	}
+
+	buf, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	res, changed, err := updateDeps(buf, deps)
+	if err != nil {
+		return fmt.Errorf("updateDeps: %w", err)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := os.WriteFile(configPath, res, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+
+	return nil
+}
+
+func updateDeps(buf []byte, deps []string) ([]byte, bool, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(buf, &doc); err != nil {
+		return nil, false, fmt.Errorf("yaml.Unmarshal: %w", err)
+	}
+
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, false, fmt.Errorf("config root is not a mapping")
+	}
+
+	seq := findDepsNode(root)
+	if seq == nil {
+		if len(deps) == 0 {
+			return buf, false, nil
+		}
+
+		seq = &yaml.Node{Kind: yaml.SequenceNode}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: depsKey}, seq)
+	}
+
+	if seq.Kind != yaml.SequenceNode {
+		// empty deps: `deps:`
+		*seq = yaml.Node{Kind: yaml.SequenceNode, HeadComment: seq.HeadComment, LineComment: seq.LineComment}
+	}
+
+	existing := make(map[string]*yaml.Node, len(seq.Content))
+	current := make([]string, 0, len(seq.Content))
+	for _, node := range seq.Content {
+		value := expandDep(node.Value)
+		existing[depName(value)] = node
+		current = append(current, value)
+	}
+
+	if slices.Equal(current, deps) {
+		return buf, false, nil
+	}
+
+	content := make([]*yaml.Node, 0, len(deps))
+	for _, dep := range deps {
+		node, ok := existing[depName(dep)]
+		if !ok {
+			node = &yaml.Node{Kind: yaml.ScalarNode, Value: dep}
+		}
+		if expandDep(node.Value) != dep {
+			node.Value = dep
+		}
+
+		content = append(content, node)
+	}
+
+	switch {
+	case len(content) == 0:
+		seq.Style = yaml.FlowStyle
+	case len(seq.Content) == 0:
+		seq.Style = 0
+	}
+	seq.Content = content
+
+	res := &bytes.Buffer{}
+	enc := yaml.NewEncoder(res)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, false, fmt.Errorf("enc.Encode: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, false, fmt.Errorf("enc.Close: %w", err)
+	}
+
+	return res.Bytes(), true, nil
+}
+
+func findDepsNode(root *yaml.Node) *yaml.Node {
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == depsKey {
+			return root.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// expandDep expands environment variables like ParseConfig does.
+func expandDep(dep string) string {
+	expanded, err := envsubst.String(dep)
+	if err != nil {
+		return dep
+	}
+
+	return expanded
+}
+
+// depName returns module name from dependency string: origin@version.
+func depName(dep string) string {
+	name, _, _ := strings.Cut(dep, "@")
+	return name
+}
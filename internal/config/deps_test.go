@@ -0,0 +1,107 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateDeps(t *testing.T) {
+	t.Setenv("EASYP_TEST_GNOSTIC", "github.com/google/gnostic@v0.7.0")
+
+	const config = `version: v1alpha
+# dependencies of the project
+deps:
+  # google apis
+  - github.com/googleapis/googleapis@common-protos-1_3_1
+  - github.com/bufbuild/protovalidate@v0.8.0 # validation
+  - ${EASYP_TEST_GNOSTIC}
+lint:
+  use:
+    - DIRECTORY_SAME_PACKAGE # keep it
+`
+
+	tests := map[string]struct {
+		config  string
+		deps    []string
+		want    string
+		changed bool
+	}{
+		"remove and update": {
+			config: config,
+			deps: []string{
+				"github.com/googleapis/googleapis@common-protos-1_3_1",
+				"github.com/google/gnostic@v0.7.0",
+				"github.com/grpc-ecosystem/grpc-gateway@v2.19.1",
+			},
+			want: `version: v1alpha
+# dependencies of the project
+deps:
+  # google apis
+  - github.com/googleapis/googleapis@common-protos-1_3_1
+  - ${EASYP_TEST_GNOSTIC}
+  - github.com/grpc-ecosystem/grpc-gateway@v2.19.1
+lint:
+  use:
+    - DIRECTORY_SAME_PACKAGE # keep it
+`,
+			changed: true,
+		},
+		"change version": {
+			config: config,
+			deps: []string{
+				"github.com/googleapis/googleapis@common-protos-1_3_1",
+				"github.com/bufbuild/protovalidate@v0.9.0",
+				"github.com/google/gnostic@v0.7.0",
+			},
+			want: `version: v1alpha
+# dependencies of the project
+deps:
+  # google apis
+  - github.com/googleapis/googleapis@common-protos-1_3_1
+  - github.com/bufbuild/protovalidate@v0.9.0 # validation
+  - ${EASYP_TEST_GNOSTIC}
+lint:
+  use:
+    - DIRECTORY_SAME_PACKAGE # keep it
+`,
+			changed: true,
+		},
+		"not changed": {
+			config: config,
+			deps: []string{
+				"github.com/googleapis/googleapis@common-protos-1_3_1",
+				"github.com/bufbuild/protovalidate@v0.8.0",
+				"github.com/google/gnostic@v0.7.0",
+			},
+			want: config,
+		},
+		"remove all": {
+			config:  "deps:\n  - github.com/googleapis/googleapis\n",
+			deps:    nil,
+			want:    "deps: []\n",
+			changed: true,
+		},
+		"add to empty deps": {
+			config:  "# project\ndeps:\nlint:\n  use:\n    - MINIMAL\n",
+			deps:    []string{"github.com/googleapis/googleapis"},
+			want:    "# project\ndeps:\n  - github.com/googleapis/googleapis\nlint:\n  use:\n    - MINIMAL\n",
+			changed: true,
+		},
+		"add deps section": {
+			config:  "lint:\n  use:\n    - MINIMAL\n",
+			deps:    []string{"github.com/googleapis/googleapis"},
+			want:    "lint:\n  use:\n    - MINIMAL\ndeps:\n  - github.com/googleapis/googleapis\n",
+			changed: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, changed, err := updateDeps([]byte(tc.config), tc.deps)
+			require.NoError(t, err)
+			require.Equal(t, tc.changed, changed)
+			require.Equal(t, tc.want, string(got))
+		})
+	}
+}
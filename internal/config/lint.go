@@ -1,5 +1,12 @@
 package config
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
 // LintConfig contains linter configuration.
 type LintConfig struct {
 	Use                 []string            `json:"use,omitempty" yaml:"use,omitempty" env:"USE"`                                                          // Use rules for linter.
@@ -9,4 +16,69 @@ type LintConfig struct {
 	Except              []string            `json:"except,omitempty" yaml:"except,omitempty" env:"EXCEPT"`                                                 // Except linter rules.
 	AllowCommentIgnores bool                `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty" env:"ALLOW_COMMENT_IGNORES"`    // Allow comment ignore.
 	IgnoreOnly          map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty" env:"IGNORE_ONLY"`
+	// Require justification after "--" in comment ignore directives.
+	CommentIgnoresRequireJustification bool              `json:"comment_ignores_require_justification,omitempty" yaml:"comment_ignores_require_justification,omitempty" env:"COMMENT_IGNORES_REQUIRE_JUSTIFICATION"`
+	Plugins                            []LintPlugin      `json:"plugins,omitempty" yaml:"plugins,omitempty"`                  // External lint plugins.
+	Severity                           map[string]string `json:"severity,omitempty" yaml:"severity,omitempty" env:"SEVERITY"` // Severity of rules or groups: error, warning, info.
+	Overrides                          []LintOverride    `json:"overrides,omitempty" yaml:"overrides,omitempty"`              // Lint configuration of files matching path.
+
+	RPCAllowSameRequestResponse          bool `json:"rpc_allow_same_request_response,omitempty" yaml:"rpc_allow_same_request_response,omitempty" env:"RPC_ALLOW_SAME_REQUEST_RESPONSE"`                               // Allow the same message as request and response of one RPC.
+	RPCAllowGoogleProtobufEmptyRequests  bool `json:"rpc_allow_google_protobuf_empty_requests,omitempty" yaml:"rpc_allow_google_protobuf_empty_requests,omitempty" env:"RPC_ALLOW_GOOGLE_PROTOBUF_EMPTY_REQUESTS"`    // Allow google.protobuf.Empty as RPC request.
+	RPCAllowGoogleProtobufEmptyResponses bool `json:"rpc_allow_google_protobuf_empty_responses,omitempty" yaml:"rpc_allow_google_protobuf_empty_responses,omitempty" env:"RPC_ALLOW_GOOGLE_PROTOBUF_EMPTY_RESPONSES"` // Allow google.protobuf.Empty as RPC response.
+}
+
+// LintOverride is the lint configuration of files matching the path.
+// It extends the base configuration, the last matching override is used for a file.
+type LintOverride struct {
+	Path                string   `json:"path" yaml:"path"`                                                         // Dir, file or doublestar glob of files.
+	Use                 []string `json:"use,omitempty" yaml:"use,omitempty"`                                       // Rules or groups enabled in addition to base ones.
+	Except              []string `json:"except,omitempty" yaml:"except,omitempty"`                                 // Rules or groups disabled for matching files.
+	EnumZeroValueSuffix string   `json:"enum_zero_value_suffix,omitempty" yaml:"enum_zero_value_suffix,omitempty"` // Enum zero value suffix.
+	ServiceSuffix       string   `json:"service_suffix,omitempty" yaml:"service_suffix,omitempty"`                 // Service suffix.
+}
+
+// Validate validates the lint override configuration.
+func (o *LintOverride) Validate() error {
+	if o.Path == "" {
+		return errors.New("lint override must have path")
+	}
+
+	if !doublestar.ValidatePattern(o.Path) {
+		return fmt.Errorf("lint override has invalid path pattern %q", o.Path)
+	}
+
+	return nil
+}
+
+// LintPlugin is the configuration of the external lint plugin.
+// Exactly one of Path, Command or Wasm has to be set.
+type LintPlugin struct {
+	Path    string     `json:"path,omitempty" yaml:"path,omitempty"`       // Path to local plugin binary.
+	Command []string   `json:"command,omitempty" yaml:"command,omitempty"` // Command for running plugin.
+	Wasm    string     `json:"wasm,omitempty" yaml:"wasm,omitempty"`       // Path to plugin WASM module.
+	Opts    PluginOpts `json:"opts,omitempty" yaml:"opts,omitempty"`       // Plugin options.
+}
+
+// Validate validates the lint plugin configuration.
+func (p *LintPlugin) Validate() error {
+	var sourceCount int
+	if p.Path != "" {
+		sourceCount++
+	}
+	if len(p.Command) > 0 {
+		sourceCount++
+	}
+	if p.Wasm != "" {
+		sourceCount++
+	}
+
+	if sourceCount > 1 {
+		return errors.New("lint plugin has multiple sources (path, command, or wasm)")
+	}
+
+	if sourceCount == 0 {
+		return errors.New("lint plugin must have one source: path, command, or wasm")
+	}
+
+	return nil
 }
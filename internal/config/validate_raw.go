@@ -5,6 +5,7 @@ import (
 	"os"
 
 	v "github.com/Yakwilik/go-yamlvalidator"
+	valv "github.com/Yakwilik/go-yamlvalidator/pkg/valuevalidator"
 	"github.com/a8m/envsubst"
 )
 
@@ -90,18 +91,61 @@ func buildSchema() *v.FieldSchema {
 		Validators:           []v.ValueValidator{pluginOptsValidator{}},
 	}
 
-	lintSchema := &v.FieldSchema{
+	lintPluginSchema := &v.FieldSchema{
 		Type: v.TypeMap,
 		AllowedKeys: map[string]*v.FieldSchema{
+			"path":    {Type: v.TypeString},
+			"command": stringSeq,
+			"wasm":    {Type: v.TypeString},
+			"opts":    pluginOptsSchema,
+		},
+		AnyOf:             [][]string{{"path"}, {"command"}, {"wasm"}},
+		MutuallyExclusive: []string{"path", "command", "wasm"},
+		UnknownKeyPolicy:  v.UnknownKeyWarn,
+	}
+
+	pathPatternSeq := &v.FieldSchema{
+		Type:       v.TypeSequence,
+		ItemSchema: &v.FieldSchema{Type: v.TypeString, Validators: []v.ValueValidator{pathPatternValidator{}}},
+	}
+
+	lintOverrideSchema := &v.FieldSchema{
+		Type: v.TypeMap,
+		AllowedKeys: map[string]*v.FieldSchema{
+			"path":                   {Type: v.TypeString, Required: true, Validators: []v.ValueValidator{pathPatternValidator{}}},
 			"use":                    stringSeq,
+			"except":                 stringSeq,
 			"enum_zero_value_suffix": {Type: v.TypeString},
 			"service_suffix":         {Type: v.TypeString},
-			"ignore":                 stringSeq,
-			"except":                 stringSeq,
-			"allow_comment_ignores":  {Type: v.TypeBool},
+		},
+		UnknownKeyPolicy: v.UnknownKeyWarn,
+	}
+
+	lintSchema := &v.FieldSchema{
+		Type: v.TypeMap,
+		AllowedKeys: map[string]*v.FieldSchema{
+			"use":                                   stringSeq,
+			"enum_zero_value_suffix":                {Type: v.TypeString},
+			"service_suffix":                        {Type: v.TypeString},
+			"ignore":                                pathPatternSeq,
+			"except":                                stringSeq,
+			"allow_comment_ignores":                 {Type: v.TypeBool},
+			"comment_ignores_require_justification": {Type: v.TypeBool},
+			"rpc_allow_same_request_response":       {Type: v.TypeBool},
+			"rpc_allow_google_protobuf_empty_requests":  {Type: v.TypeBool},
+			"rpc_allow_google_protobuf_empty_responses": {Type: v.TypeBool},
 			"ignore_only": {
 				Type:                 v.TypeMap,
-				AdditionalProperties: stringSeq,
+				AdditionalProperties: pathPatternSeq,
+			},
+			"plugins":   {Type: v.TypeSequence, ItemSchema: lintPluginSchema},
+			"overrides": {Type: v.TypeSequence, ItemSchema: lintOverrideSchema},
+			"severity": {
+				Type: v.TypeMap,
+				AdditionalProperties: &v.FieldSchema{
+					Type:       v.TypeString,
+					Validators: []v.ValueValidator{valv.EnumValidator{Allowed: []string{"error", "warning", "info"}}},
+				},
 			},
 		},
 		UnknownKeyPolicy: v.UnknownKeyWarn,
@@ -213,8 +257,17 @@ func buildSchema() *v.FieldSchema {
 	breakingSchema := &v.FieldSchema{
 		Type: v.TypeMap,
 		AllowedKeys: map[string]*v.FieldSchema{
-			"ignore":          stringSeq,
-			"against_git_ref": {Type: v.TypeString},
+			"use":    stringSeq,
+			"except": stringSeq,
+			"ignore": pathPatternSeq,
+			"ignore_only": {
+				Type:                 v.TypeMap,
+				AdditionalProperties: pathPatternSeq,
+			},
+			"ignore_unstable_packages": {Type: v.TypeBool},
+			"against_git_ref":          {Type: v.TypeString},
+			"against_module":           {Type: v.TypeString},
+			"against_input":            {Type: v.TypeString},
 		},
 		UnknownKeyPolicy: v.UnknownKeyWarn,
 	}
@@ -150,14 +150,22 @@ func TestValidateRaw_BreakingSchemaValidKeys(t *testing.T) {
   use:
     - DIRECTORY_SAME_PACKAGE
 breaking:
+  use:
+    - WIRE_JSON
+  except:
+    - FIELD_SAME_JSON_NAME
   ignore:
     - proto/legacy
+  ignore_only:
+    FIELD_NO_DELETE:
+      - "proto/internal/**"
+  ignore_unstable_packages: true
   against_git_ref: main
 `
 
 	issues, err := ValidateRaw([]byte(content))
 	require.NoError(t, err)
-	require.False(t, HasErrors(issues), "valid breaking section should not produce errors, got: %v", issues)
+	require.Empty(t, issues, "valid breaking section should not produce issues")
 }
 
 func TestValidateRaw_BreakingSchemaUnknownKey(t *testing.T) {
@@ -184,6 +192,107 @@ breaking:
 	require.True(t, hasWarning, "expected warning for unknown key 'unknown_field' in breaking section, got: %v", issues)
 }
 
+func TestValidateRaw_LintPlugins(t *testing.T) {
+	content := `lint:
+  use:
+    - DIRECTORY_SAME_PACKAGE
+  plugins:
+    - path: ./bin/easyp-lint-acme
+      opts:
+        max_fields: 10
+    - command: ["go", "run", "./cmd/lint-plugin"]
+    - wasm: ./plugins/lint.wasm
+`
+
+	issues, err := ValidateRaw([]byte(content))
+	require.NoError(t, err)
+	require.False(t, HasErrors(issues), "valid lint plugins should not produce errors, got: %v", issues)
+}
+
+func TestValidateRaw_LintPluginMultipleSources(t *testing.T) {
+	content := `lint:
+  use:
+    - DIRECTORY_SAME_PACKAGE
+  plugins:
+    - path: ./bin/easyp-lint-acme
+      wasm: ./plugins/lint.wasm
+`
+
+	issues, err := ValidateRaw([]byte(content))
+	require.NoError(t, err)
+	require.True(t, HasErrors(issues), "lint plugin with several sources should produce error, got: %v", issues)
+}
+
+func TestValidateRaw_LintSeverity(t *testing.T) {
+	tests := map[string]struct {
+		severity  string
+		wantError bool
+	}{
+		"valid":         {severity: "warning"},
+		"invalid_level": {severity: "fatal", wantError: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			content := `lint:
+  use:
+    - COMMENTS
+  severity:
+    COMMENTS: info
+    COMMENT_SERVICE: ` + tc.severity + `
+`
+
+			issues, err := ValidateRaw([]byte(content))
+			require.NoError(t, err)
+			require.Equal(t, tc.wantError, HasErrors(issues), "unexpected issues: %v", issues)
+		})
+	}
+}
+
+func TestValidateRaw_LintOverrides(t *testing.T) {
+	tests := map[string]struct {
+		overrides string
+		wantError bool
+	}{
+		"valid": {
+			overrides: `
+    - path: legacy/**
+      except:
+        - COMMENTS
+      service_suffix: API
+`,
+		},
+		"without_path": {
+			overrides: `
+    - use:
+        - COMMENTS
+`,
+			wantError: true,
+		},
+		"invalid_pattern": {
+			overrides: `
+    - path: "legacy/[v1"
+`,
+			wantError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			content := `lint:
+  use:
+    - DEFAULT
+  ignore:
+    - "**/*_internal.proto"
+  overrides:` + tc.overrides
+
+			issues, err := ValidateRaw([]byte(content))
+			require.NoError(t, err)
+			require.Equal(t, tc.wantError, HasErrors(issues), "unexpected issues: %v", issues)
+		})
+	}
+}
+
 func TestValidateRaw_ManagedModePackageSelectors(t *testing.T) {
 	content := `lint:
   use:
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	v "github.com/Yakwilik/go-yamlvalidator"
+	"github.com/bmatcuk/doublestar/v4"
 	"gopkg.in/yaml.v3"
 )
 
@@ -267,3 +268,21 @@ func (managedOverrideValidator) Validate(node *yaml.Node, path string, ctx *v.Va
 		})
 	}
 }
+
+// pathPatternValidator ensures path is a valid doublestar glob.
+type pathPatternValidator struct{}
+
+func (pathPatternValidator) Validate(node *yaml.Node, path string, ctx *v.ValidationContext) {
+	if node.Kind != yaml.ScalarNode || doublestar.ValidatePattern(node.Value) {
+		return
+	}
+
+	ctx.AddError(v.ValidationError{
+		Level:   v.LevelError,
+		Path:    path,
+		Line:    node.Line,
+		Column:  node.Column,
+		Message: "invalid path pattern",
+		Got:     node.Value,
+	})
+}
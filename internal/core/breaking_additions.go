@@ -0,0 +1,217 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+// Kinds of added elements.
+const (
+	AdditionKindFile      = "file"
+	AdditionKindService   = "service"
+	AdditionKindRPC       = "rpc"
+	AdditionKindMessage   = "message"
+	AdditionKindField     = "field"
+	AdditionKindOneOf     = "oneof"
+	AdditionKindEnum      = "enum"
+	AdditionKindEnumValue = "enum value"
+)
+
+// Addition is an element of current proto files which is not present in against ones.
+type Addition struct {
+	Kind string `json:"kind"`
+	// Name is fully-qualified name of the element or path of the file.
+	Name     string        `json:"name"`
+	Path     string        `json:"path"`
+	Position meta.Position `json:"position"`
+}
+
+// Additions walks current proto files and returns elements which are not present in against ones.
+// Elements of added services, messages and enums are not listed separately, fields are compared by numbers.
+// Additions are sorted by path and position.
+func (b *BreakingChecker) Additions() []Addition {
+	res := make([]Addition, 0)
+
+	for packageName, collection := range b.current {
+		if b.ignoreUnstablePackages && isUnstablePackage(packageName) {
+			continue
+		}
+
+		res = append(res, b.packageAdditions(collection)...)
+	}
+
+	slices.SortFunc(res, func(a, b Addition) int {
+		return cmp.Or(
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Position.Line, b.Position.Line),
+			cmp.Compare(a.Position.Column, b.Position.Column),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return res
+}
+
+func (b *BreakingChecker) packageAdditions(collection *Collection) []Addition {
+	res := make([]Addition, 0)
+
+	for _, currentFile := range collection.Files {
+		if _, ok := getFile(b.against, currentFile.ProtoFilePath); !ok {
+			res = append(res, Addition{
+				Kind:     AdditionKindFile,
+				Name:     currentFile.ProtoFilePath,
+				Path:     currentFile.ProtoFilePath,
+				Position: filePosition(currentFile.Proto),
+			})
+		}
+	}
+
+	for _, currentService := range collection.Services {
+		res = append(res, b.serviceAdditions(currentService)...)
+	}
+
+	for _, currentMessage := range collection.Messages {
+		res = append(res, b.messageAdditions(currentMessage)...)
+	}
+
+	for _, currentOneOf := range collection.OneOfs {
+		_, messageExists := getMessage(b.against, currentOneOf.PackageName, oneOfMessagePath(currentOneOf))
+		_, ok := getOneOf(b.against, currentOneOf.PackageName, currentOneOf.OneOfPath)
+		if messageExists && !ok {
+			res = append(res, Addition{
+				Kind:     AdditionKindOneOf,
+				Name:     getProtoEntityPath(string(currentOneOf.PackageName), currentOneOf.OneOfPath),
+				Path:     currentOneOf.ProtoFilePath,
+				Position: currentOneOf.Meta.Pos,
+			})
+		}
+	}
+
+	for _, currentEnum := range collection.Enums {
+		res = append(res, b.enumAdditions(currentEnum)...)
+	}
+
+	return res
+}
+
+func (b *BreakingChecker) serviceAdditions(currentService Service) []Addition {
+	serviceName := getProtoEntityPath(string(currentService.PackageName), currentService.ServiceName)
+
+	againstService, ok := getService(b.against, currentService.PackageName, currentService.ServiceName)
+	if !ok {
+		return []Addition{{
+			Kind:     AdditionKindService,
+			Name:     serviceName,
+			Path:     currentService.ProtoFilePath,
+			Position: currentService.Meta.Pos,
+		}}
+	}
+
+	res := make([]Addition, 0)
+
+	for _, currentRPC := range currentService.ServiceBody.RPCs {
+		if _, ok := searchRPC(againstService.ServiceBody.RPCs, currentRPC.RPCName); ok {
+			continue
+		}
+
+		res = append(res, Addition{
+			Kind:     AdditionKindRPC,
+			Name:     getProtoEntityPath(serviceName, currentRPC.RPCName),
+			Path:     currentService.ProtoFilePath,
+			Position: currentRPC.Meta.Pos,
+		})
+	}
+
+	return res
+}
+
+func (b *BreakingChecker) messageAdditions(currentMessage Message) []Addition {
+	messageName := getProtoEntityPath(string(currentMessage.PackageName), currentMessage.MessagePath)
+
+	againstMessage, ok := getMessage(b.against, currentMessage.PackageName, currentMessage.MessagePath)
+	if !ok {
+		if b.isParentMessageAdded(currentMessage.PackageName, currentMessage.MessagePath) {
+			return nil
+		}
+
+		return []Addition{{
+			Kind:     AdditionKindMessage,
+			Name:     messageName,
+			Path:     currentMessage.ProtoFilePath,
+			Position: currentMessage.Meta.Pos,
+		}}
+	}
+
+	againstNumbers := make(map[string]struct{})
+	for _, field := range messageFields(againstMessage.Message) {
+		againstNumbers[field.number] = struct{}{}
+	}
+
+	res := make([]Addition, 0)
+
+	for _, field := range messageFields(currentMessage.Message) {
+		if _, ok := againstNumbers[field.number]; ok {
+			continue
+		}
+
+		res = append(res, Addition{
+			Kind:     AdditionKindField,
+			Name:     getProtoEntityPath(messageName, field.name),
+			Path:     currentMessage.ProtoFilePath,
+			Position: field.pos,
+		})
+	}
+
+	return res
+}
+
+func (b *BreakingChecker) enumAdditions(currentEnum Enum) []Addition {
+	enumName := getProtoEntityPath(string(currentEnum.PackageName), currentEnum.EnumPath)
+
+	againstEnum, ok := getEnum(b.against, currentEnum.PackageName, currentEnum.EnumPath)
+	if !ok {
+		if b.isParentMessageAdded(currentEnum.PackageName, currentEnum.EnumPath) {
+			return nil
+		}
+
+		return []Addition{{
+			Kind:     AdditionKindEnum,
+			Name:     enumName,
+			Path:     currentEnum.ProtoFilePath,
+			Position: currentEnum.Meta.Pos,
+		}}
+	}
+
+	res := make([]Addition, 0)
+
+	for _, currentField := range currentEnum.EnumBody.EnumFields {
+		if _, ok := searchEnumField(againstEnum.EnumBody.EnumFields, currentField.Number); ok {
+			continue
+		}
+
+		res = append(res, Addition{
+			Kind:     AdditionKindEnumValue,
+			Name:     getProtoEntityPath(enumName, currentField.Ident),
+			Path:     currentEnum.ProtoFilePath,
+			Position: currentField.Meta.Pos,
+		})
+	}
+
+	return res
+}
+
+// isParentMessageAdded reports whether the element with the path is nested in an added message,
+// such elements are not listed, because the message is listed itself.
+func (b *BreakingChecker) isParentMessageAdded(packageName PackageName, path string) bool {
+	i := strings.LastIndex(path, ".")
+	if i == -1 {
+		return false
+	}
+
+	_, ok := getMessage(b.against, packageName, path[:i])
+
+	return !ok
+}
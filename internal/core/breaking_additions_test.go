@@ -0,0 +1,46 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+func TestBreakingChecker_Additions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		originalAdditionsDir = "../../testdata/breaking_check/additions/original"
+		currentAdditionsDir  = "../../testdata/breaking_check/additions/current"
+	)
+
+	c := &Core{logger: logger.NewNop()}
+
+	breakingChecker := &BreakingChecker{
+		against: readProtoData(t, c, originalAdditionsDir),
+		current: readProtoData(t, c, currentAdditionsDir),
+	}
+
+	got := make([]string, 0)
+	for _, addition := range breakingChecker.Additions() {
+		got = append(got, fmt.Sprintf("%s:%d %s %s", addition.Path, addition.Position.Line, addition.Kind, addition.Name))
+	}
+
+	// elements nested in added message are not listed
+	require.Equal(t, []string{
+		"additions.proto:7 rpc additions.v1.Service.List",
+		"additions.proto:10 service additions.v1.Admin",
+		"additions.proto:16 field additions.v1.Request.name",
+		"additions.proto:17 field additions.v1.Request.labels",
+		"additions.proto:18 oneof additions.v1.Request.filter",
+		"additions.proto:19 field additions.v1.Request.query",
+		"additions.proto:24 message additions.v1.Response.Item",
+		"additions.proto:37 enum value additions.v1.Status.STATUS_ACTIVE",
+		"additions.proto:40 enum additions.v1.Role",
+		"new.proto:3 file new.proto",
+		"new.proto:5 message additions.v1.New",
+	}, got)
+}
@@ -0,0 +1,227 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/codeclysm/extract/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+var (
+	ErrAgainstConflict     = errors.New("only one of against module and against input can be set")
+	ErrUnknownAgainstInput = errors.New("unknown against input, expected directory, archive or descriptor set")
+	ErrInvalidFileName     = errors.New("file name of descriptor set must be a local path")
+)
+
+// againstWalker returns walker over the state which current files are compared with:
+// a module version, a local input (directory, archive or descriptor set) or the git ref.
+// Returned cleanup func removes temporary files of the walker.
+func (c *Core) againstWalker(ctx context.Context, workingDir, gitRef, path string) (DirWalker, func(), error) {
+	againstModule := c.breakingCheckConfig.AgainstModule
+	againstInput := c.breakingCheckConfig.AgainstInput
+
+	switch {
+	case againstModule != "" && againstInput != "":
+		return nil, nil, ErrAgainstConflict
+	case againstModule != "":
+		walker, err := c.moduleAgainstWalker(ctx, againstModule, path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("c.moduleAgainstWalker: %w", err)
+		}
+
+		return walker, func() {}, nil
+	case againstInput != "":
+		walker, cleanup, err := inputAgainstWalker(ctx, againstInput, path, c.isDependencyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("inputAgainstWalker: %w", err)
+		}
+
+		return walker, cleanup, nil
+	}
+
+	walker, err := c.currentProjectGitWalker.GetDirWalker(workingDir, gitRef, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("c.currentProjectGitWalker.GetDirWalker: %w", err)
+	}
+
+	return walker, func() {}, nil
+}
+
+// moduleAgainstWalker installs the module version into storage and walks it.
+// Lock file is not changed, because the module is not a dependency of the project.
+func (c *Core) moduleAgainstWalker(ctx context.Context, againstModule, path string) (DirWalker, error) {
+	module := models.NewModule(againstModule)
+
+	installedModuleInfo, err := c.install(ctx, module)
+	if err != nil {
+		return nil, fmt.Errorf("c.install: %w", err)
+	}
+
+	installDir := c.storage.GetInstallDir(module.Name, installedModuleInfo.RevisionVersion)
+
+	return fs.NewFSWalker(installDir, path), nil
+}
+
+// inputAgainstWalker walks a directory, an extracted archive or proto files printed from a descriptor set.
+// Files of descriptor set which are provided by dependencies (e.g. google/protobuf/timestamp.proto
+// of sets built with --include_imports) are only used as imports and aren't walked.
+func inputAgainstWalker(
+	ctx context.Context, input, path string, isImport func(name string) bool,
+) (DirWalker, func(), error) {
+	info, err := os.Stat(input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("os.Stat: %w", err)
+	}
+
+	if info.IsDir() {
+		return fs.NewFSWalker(input, path), func() {}, nil
+	}
+
+	tempDir, err := os.MkdirTemp("", "easyp-against-*")
+	if err != nil {
+		return nil, nil, fmt.Errorf("os.MkdirTemp: %w", err)
+	}
+	cleanup := func() { _ = os.RemoveAll(tempDir) }
+
+	filesDir := filepath.Join(tempDir, "files")
+	importsDir := filepath.Join(tempDir, "imports")
+
+	root, err := unpackAgainstInput(ctx, input, filesDir, importsDir, path, isImport)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	walker := &importsWalker{
+		DirWalker: fs.NewFSWalker(root, path),
+		imports:   fs.NewFSWalker(importsDir, "."),
+	}
+
+	return walker, cleanup, nil
+}
+
+// importsWalker walks files of the walker and opens files which aren't found there from imports.
+type importsWalker struct {
+	DirWalker
+
+	imports FS
+}
+
+func (w *importsWalker) Open(name string) (io.ReadCloser, error) {
+	f, err := w.DirWalker.Open(name)
+	if err == nil {
+		return f, nil
+	}
+
+	return w.imports.Open(name)
+}
+
+// unpackAgainstInput unpacks archive or descriptor set into dir and returns root of proto files.
+// Imported files of descriptor set are written into importsDir.
+func unpackAgainstInput(
+	ctx context.Context, input, dir, importsDir, path string, isImport func(name string) bool,
+) (string, error) {
+	switch {
+	case strings.HasSuffix(input, ".tar.gz"), strings.HasSuffix(input, ".tgz"), strings.HasSuffix(input, ".zip"):
+		if err := extractArchive(ctx, input, dir); err != nil {
+			return "", fmt.Errorf("extractArchive: %w", err)
+		}
+
+		root, err := archiveRoot(dir, path)
+		if err != nil {
+			return "", fmt.Errorf("archiveRoot: %w", err)
+		}
+
+		return root, nil
+	case strings.HasSuffix(input, ".binpb"), strings.HasSuffix(input, ".pb"), strings.HasSuffix(input, ".bin"):
+		if err := printDescriptorSet(input, dir, importsDir, isImport); err != nil {
+			return "", fmt.Errorf("printDescriptorSet: %w", err)
+		}
+
+		return dir, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownAgainstInput, input)
+	}
+}
+
+func extractArchive(ctx context.Context, archive, dir string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return fmt.Errorf("os.Open: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if err := extract.Archive(ctx, f, dir, nil); err != nil {
+		return fmt.Errorf("extract.Archive: %w", err)
+	}
+
+	return nil
+}
+
+// archiveRoot returns the single top level directory of the archive (e.g. repo-v1.4.0 of release archives)
+// unless the checked path starts with it, otherwise the archive dir itself.
+func archiveRoot(dir, path string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("os.ReadDir: %w", err)
+	}
+
+	if len(entries) != 1 || !entries[0].IsDir() {
+		return dir, nil
+	}
+
+	firstPathPart := strings.Split(filepath.ToSlash(filepath.Clean(path)), "/")[0]
+	if entries[0].Name() == firstPathPart {
+		return dir, nil
+	}
+
+	return filepath.Join(dir, entries[0].Name()), nil
+}
+
+// printDescriptorSet writes files of the binary FileDescriptorSet into dir as proto sources,
+// imported files are written into importsDir.
+func printDescriptorSet(input, dir, importsDir string, isImport func(name string) bool) error {
+	content, err := os.ReadFile(input)
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	descriptorSet := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(content, descriptorSet); err != nil {
+		return fmt.Errorf("proto.Unmarshal: %w", err)
+	}
+
+	for _, fd := range descriptorSet.GetFile() {
+		// names come from the input, so they must not point outside of dir
+		if !filepath.IsLocal(filepath.FromSlash(fd.GetName())) {
+			return fmt.Errorf("%w: %s", ErrInvalidFileName, fd.GetName())
+		}
+
+		root := dir
+		if isImport(fd.GetName()) {
+			root = importsDir
+		}
+
+		filePath := filepath.Join(root, filepath.FromSlash(fd.GetName()))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			return fmt.Errorf("os.MkdirAll: %w", err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(printFileDescriptor(fd)), 0o644); err != nil {
+			return fmt.Errorf("os.WriteFile: %w", err)
+		}
+	}
+
+	return nil
+}
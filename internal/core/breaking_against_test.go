@@ -0,0 +1,269 @@
+package core
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+func TestCore_againstWalker_conflict(t *testing.T) {
+	t.Parallel()
+
+	c := &Core{
+		breakingCheckConfig: BreakingCheckConfig{
+			AgainstModule: "github.com/acme/apis@v1.4.0",
+			AgainstInput:  originalDir,
+		},
+	}
+
+	_, _, err := c.againstWalker(context.Background(), ".", "master", ".")
+	require.ErrorIs(t, err, ErrAgainstConflict)
+}
+
+func TestInputAgainstWalker(t *testing.T) {
+	t.Parallel()
+
+	c := &Core{logger: logger.NewNop()}
+	tempDir := t.TempDir()
+
+	archive := filepath.Join(tempDir, "apis-v1.4.0.tar.gz")
+	writeTarGz(t, originalDir, "apis-v1.4.0", archive)
+
+	unknown := filepath.Join(tempDir, "apis.txt")
+	require.NoError(t, os.WriteFile(unknown, nil, 0o644))
+
+	tests := map[string]struct {
+		input   string
+		wantErr error
+	}{
+		"dir":     {input: originalDir},
+		"archive": {input: archive},
+		"unknown": {input: unknown, wantErr: ErrUnknownAgainstInput},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			walker, cleanup, err := inputAgainstWalker(context.Background(), tc.input, ".", c.isDependencyFile)
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+			defer cleanup()
+
+			protoInfo, err := c.readProtoFiles(context.Background(), walker)
+			require.NoError(t, err)
+
+			against, err := collect(protoInfo)
+			require.NoError(t, err)
+
+			current := readProtoData(t, c, brokenDir)
+			require.ElementsMatch(t,
+				breakingIssueStrings(t, readProtoData(t, c, originalDir), current),
+				breakingIssueStrings(t, against, current),
+			)
+		})
+	}
+}
+
+func TestInputAgainstWalker_DescriptorSet(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		againstDir string
+		currentDir string
+	}{
+		"broken": {
+			againstDir: originalDir,
+			currentDir: brokenDir,
+		},
+		"changes": {
+			againstDir: "../../testdata/breaking_check/changes/original",
+			currentDir: "../../testdata/breaking_check/changes/current",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Core{logger: logger.NewNop()}
+			current := readProtoData(t, c, tc.currentDir)
+
+			image := filepath.Join(t.TempDir(), "image.binpb")
+			writeDescriptorSet(t, c, tc.againstDir, image)
+
+			walker, cleanup, err := inputAgainstWalker(context.Background(), image, ".", c.isDependencyFile)
+			require.NoError(t, err)
+			defer cleanup()
+
+			protoInfo, err := c.readProtoFiles(context.Background(), walker)
+			require.NoError(t, err)
+
+			against, err := collect(protoInfo)
+			require.NoError(t, err)
+
+			// issues from descriptor set must be the same as from sources, except positions
+			require.ElementsMatch(t,
+				breakingIssueStrings(t, readProtoData(t, c, tc.againstDir), current),
+				breakingIssueStrings(t, against, current),
+			)
+		})
+	}
+}
+
+func TestInputAgainstWalker_DescriptorSetWithImports(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "event.proto"), []byte(`syntax = "proto3";
+
+package events;
+
+import "google/protobuf/timestamp.proto";
+
+message Event {
+  google.protobuf.Timestamp created_at = 1;
+}
+`), 0o644))
+
+	c := &Core{logger: logger.NewNop()}
+	res, err := c.compileProtoFile(context.Background(), fs.NewFSWalker(dir, "."), "event.proto")
+	require.NoError(t, err)
+
+	// the same as protoc --include_imports: imported files are before files importing them
+	descriptorSet := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(res.Imports().Get(0).FileDescriptor),
+			protodesc.ToFileDescriptorProto(res),
+		},
+	}
+	content, err := proto.Marshal(descriptorSet)
+	require.NoError(t, err)
+
+	image := filepath.Join(t.TempDir(), "image.binpb")
+	require.NoError(t, os.WriteFile(image, content, 0o644))
+
+	walker, cleanup, err := inputAgainstWalker(context.Background(), image, ".", c.isDependencyFile)
+	require.NoError(t, err)
+	defer cleanup()
+
+	protoInfo, err := c.readProtoFiles(context.Background(), walker)
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(protoInfo))
+	for _, info := range protoInfo {
+		require.NotNil(t, info.Descriptor, info.Path)
+		paths = append(paths, info.Path)
+	}
+	require.Equal(t, []string{"event.proto"}, paths)
+
+	against, err := collect(protoInfo)
+	require.NoError(t, err)
+
+	require.Empty(t, breakingIssueStrings(t, against, readProtoData(t, c, dir)))
+}
+
+func TestPrintDescriptorSet_InvalidFileName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"parent":   "../evil.proto",
+		"nested":   "foo/../../evil.proto",
+		"absolute": "/tmp/evil.proto",
+	}
+
+	for name, fileName := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			content, err := proto.Marshal(&descriptorpb.FileDescriptorSet{
+				File: []*descriptorpb.FileDescriptorProto{{Name: proto.String(fileName)}},
+			})
+			require.NoError(t, err)
+
+			image := filepath.Join(t.TempDir(), "image.binpb")
+			require.NoError(t, os.WriteFile(image, content, 0o644))
+
+			dir := t.TempDir()
+			err = printDescriptorSet(image, dir, dir, func(string) bool { return false })
+			require.ErrorIs(t, err, ErrInvalidFileName)
+		})
+	}
+}
+
+func breakingIssueStrings(t *testing.T, against, current ProtoData) []string {
+	t.Helper()
+
+	issues, err := (&BreakingChecker{against: against, current: current}).Check()
+	require.NoError(t, err)
+
+	res := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		res = append(res, fmt.Sprintf("%s %s %s", issue.Path, issue.RuleName, issue.Message))
+	}
+
+	return res
+}
+
+func writeDescriptorSet(t *testing.T, c *Core, dir, output string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	descriptorSet := &descriptorpb.FileDescriptorSet{}
+	for _, entry := range entries {
+		res, err := c.compileProtoFile(context.Background(), fs.NewFSWalker(dir, "."), entry.Name())
+		require.NoError(t, err)
+
+		descriptorSet.File = append(descriptorSet.File, protodesc.ToFileDescriptorProto(res))
+	}
+
+	content, err := proto.Marshal(descriptorSet)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(output, content, 0o644))
+}
+
+func writeTarGz(t *testing.T, dir, prefix, output string) {
+	t.Helper()
+
+	f, err := os.Create(output)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+
+	gw := gzip.NewWriter(f)
+	defer func() { require.NoError(t, gw.Close()) }()
+
+	tw := tar.NewWriter(gw)
+	defer func() { require.NoError(t, tw.Close()) }()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: prefix + "/" + entry.Name(),
+			Mode: 0o644,
+			Size: int64(len(content)),
+		}))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+}
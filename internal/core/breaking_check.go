@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"slices"
 
 	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
@@ -18,8 +19,18 @@ var ErrRootOutsideProject = fmt.Errorf("breaking check root must be inside the g
 type BreakingCheckConfig struct {
 	// branch name to compare with
 	AgainstGitRef string
+	// AgainstModule is a module version to compare with instead of git ref, e.g. github.com/acme/apis@v1.4.0.
+	AgainstModule string
+	// AgainstInput is a directory, an archive or a descriptor set to compare with instead of git ref.
+	AgainstInput string
 	// dirs should be ignored
 	IgnoreDirs []string
+	// Rules contains names of enabled breaking rules, all rules are enabled if it is nil.
+	Rules []string
+	// IgnoreOnly contains files or dirs which should be ignored by rule name.
+	IgnoreOnly map[string][]string
+	// IgnoreUnstablePackages disables checks of alpha, beta and test packages, e.g. foo.v1beta1.
+	IgnoreUnstablePackages bool
 }
 
 func (c *Core) BreakingCheck(ctx context.Context, projectRoot, workingDir, path string) ([]IssueInfo, error) {
@@ -30,6 +41,31 @@ func (c *Core) BreakingCheck(ctx context.Context, projectRoot, workingDir, path
 		slog.String("path", path),
 	)
 
+	breakingChecker, err := c.newBreakingChecker(ctx, workingDir, c.breakingCheckConfig.AgainstGitRef, path)
+	if err != nil {
+		return nil, fmt.Errorf("c.newBreakingChecker: %w", err)
+	}
+
+	issues, err := breakingChecker.Check()
+	if err != nil {
+		return nil, fmt.Errorf("breakingChecker.Check: %w", err)
+	}
+
+	return c.filterBreakingIssues(issues), nil
+}
+
+// BreakingAdditions returns elements added to current proto files against git ref, module or input.
+func (c *Core) BreakingAdditions(ctx context.Context, workingDir, path string) ([]Addition, error) {
+	breakingChecker, err := c.newBreakingChecker(ctx, workingDir, c.breakingCheckConfig.AgainstGitRef, path)
+	if err != nil {
+		return nil, fmt.Errorf("c.newBreakingChecker: %w", err)
+	}
+
+	return breakingChecker.Additions(), nil
+}
+
+// newBreakingChecker reads current proto files and proto files of the against state and collects them.
+func (c *Core) newBreakingChecker(ctx context.Context, workingDir, gitRef, path string) (*BreakingChecker, error) {
 	if err := c.Download(ctx); err != nil {
 		return nil, fmt.Errorf("c.Download: %w", err)
 	}
@@ -41,13 +77,13 @@ func (c *Core) BreakingCheck(ctx context.Context, projectRoot, workingDir, path
 		return nil, fmt.Errorf("c.readCurrentProtoFiles: %w", err)
 	}
 
-	// read from ref branch
-	againstFSWalker, err := c.currentProjectGitWalker.GetDirWalker(
-		workingDir, c.breakingCheckConfig.AgainstGitRef, path,
-	)
+	// read from ref branch, module or input
+	againstFSWalker, cleanup, err := c.againstWalker(ctx, workingDir, gitRef, path)
 	if err != nil {
-		return nil, fmt.Errorf("c.currentProjectGitWalker.GetDirWalker: %w", err)
+		return nil, fmt.Errorf("c.againstWalker: %w", err)
 	}
+	defer cleanup()
+
 	againstProtoFiles, err := c.readProtoFiles(ctx, againstFSWalker)
 	if err != nil {
 		return nil, fmt.Errorf("c.readAgainstProtoFiles: %w", err)
@@ -65,16 +101,35 @@ func (c *Core) BreakingCheck(ctx context.Context, projectRoot, workingDir, path
 		return nil, fmt.Errorf("collect(against): %w", err)
 	}
 
-	breakingChecker := &BreakingChecker{
-		against: againstProtoData,
-		current: currentProtoData,
+	return &BreakingChecker{
+		against:                againstProtoData,
+		current:                currentProtoData,
+		ignoreUnstablePackages: c.breakingCheckConfig.IgnoreUnstablePackages,
+	}, nil
+}
+
+// filterBreakingIssues removes issues of disabled rules and issues ignored by ignore_only.
+func (c *Core) filterBreakingIssues(issues []IssueInfo) []IssueInfo {
+	res := issues[:0]
+
+	for _, issue := range issues {
+		if c.breakingCheckConfig.Rules != nil && !slices.Contains(c.breakingCheckConfig.Rules, issue.RuleName) {
+			continue
+		}
+
+		if isIgnoredOnly(c.breakingCheckConfig.IgnoreOnly[issue.RuleName], issue.Path) {
+			continue
+		}
+
+		res = append(res, issue)
 	}
 
-	return breakingChecker.Check()
+	return res
 }
 
 func (c *Core) readProtoFiles(ctx context.Context, fsWalker DirWalker) ([]ProtoInfo, error) {
 	protoFiles := make([]ProtoInfo, 0)
+	compiler := c.newProtoCompiler(fsWalker)
 
 	err := fsWalker.WalkDir(func(path string, err error) error {
 		switch {
@@ -93,6 +148,16 @@ func (c *Core) readProtoFiles(ctx context.Context, fsWalker DirWalker) ([]ProtoI
 			return fmt.Errorf("c.protoInfoRead: %w", err)
 		}
 
+		descriptor, err := compiler.compile(ctx, path)
+		if err != nil {
+			// types of the file are resolved by names if it can't be compiled,
+			// it's less accurate (e.g. for nested and imported types), so it's reported
+			c.logger.Warn(ctx, "failed to compile proto file, its types are resolved by names",
+				slog.String("path", path), slog.Any("error", err))
+		} else {
+			protoInfo.Descriptor = descriptor
+		}
+
 		protoFiles = append(protoFiles, protoInfo)
 		return nil
 	})
@@ -106,6 +171,7 @@ func (c *Core) readProtoFiles(ctx context.Context, fsWalker DirWalker) ([]ProtoI
 func collect(protoInfos []ProtoInfo) (ProtoData, error) {
 	protoData := make(ProtoData)
 	collectedProtoFiles := make(map[string]struct{})
+	attachedDescriptors := make(map[string]struct{})
 
 	for _, protoInfo := range protoInfos {
 		protoFilePath := protoInfo.Path
@@ -116,6 +182,12 @@ func collect(protoInfos []ProtoInfo) (ProtoData, error) {
 			collectedProtoFiles[protoFilePath] = struct{}{}
 		}
 
+		protoData[pkgName].Files[protoFilePath] = File{
+			ProtoFilePath: protoFilePath,
+			PackageName:   pkgName,
+			Proto:         protoInfo.Info,
+		}
+
 		// collects from imports
 		for importPath, protoFile := range protoInfo.ProtoFilesFromImport {
 			protoFilePath := string(importPath)
@@ -129,6 +201,13 @@ func collect(protoInfos []ProtoInfo) (ProtoData, error) {
 		}
 	}
 
+	// descriptors are attached after all files are collected, because a file can be collected after its importer
+	for _, protoInfo := range protoInfos {
+		if protoInfo.Descriptor != nil {
+			attachDescriptors(protoData, protoInfo.Descriptor, attachedDescriptors)
+		}
+	}
+
 	return protoData, nil
 }
 
@@ -238,6 +317,7 @@ func getProtoEntityPath(rootPath, name string) string {
 
 func newCollection() *Collection {
 	collection := &Collection{
+		Files:    make(map[string]File),
 		Imports:  make(map[ImportPath]Import),
 		Services: make(map[string]Service),
 		Messages: make(map[string]Message),
@@ -2,23 +2,40 @@ package core
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 )
 
-const breakingCheckRuleName = "BREAKING_CHECK"
+// maxFieldNumber is the value of "max" in reserved ranges.
+const maxFieldNumber = 536870911
 
 type BreakingChecker struct {
 	against ProtoData
 	current ProtoData
+	// ignoreUnstablePackages disables checks of alpha, beta and test packages, see isUnstablePackage.
+	ignoreUnstablePackages bool
+
+	// fully-qualified names of messages and enums, types are compared by them
+	againstTypes map[string]struct{}
+	currentTypes map[string]struct{}
 }
 
 func (b *BreakingChecker) Check() ([]IssueInfo, error) {
 	res := make([]IssueInfo, 0)
 
+	b.againstTypes = typeNames(b.against)
+	b.currentTypes = typeNames(b.current)
+
 	// iterate over packages
 	for packageName, collection := range b.against {
+		if b.ignoreUnstablePackages && isUnstablePackage(packageName) {
+			continue
+		}
+
 		issues := b.checkPackage(packageName, collection)
 
 		res = append(res, issues...)
@@ -30,6 +47,11 @@ func (b *BreakingChecker) Check() ([]IssueInfo, error) {
 func (b *BreakingChecker) checkPackage(packageName PackageName, collection *Collection) []IssueInfo {
 	res := make([]IssueInfo, 0)
 
+	for _, againstFile := range collection.Files {
+		issues := b.checkFile(againstFile)
+		res = append(res, issues...)
+	}
+
 	for _, againstImport := range collection.Imports {
 		issues := b.checkImports(againstImport)
 		res = append(res, issues...)
@@ -58,12 +80,82 @@ func (b *BreakingChecker) checkPackage(packageName PackageName, collection *Coll
 	return res
 }
 
+// ===== FILES =====
+
+func (b *BreakingChecker) checkFile(againstFile File) []IssueInfo {
+	res := make([]IssueInfo, 0)
+
+	currentFile, ok := getFile(b.current, againstFile.ProtoFilePath)
+	if !ok {
+		issue := getFileDeletedIssue(againstFile)
+		res = append(res, issue)
+		return res
+	}
+
+	if againstFile.PackageName != currentFile.PackageName {
+		issue := getFileChangedPackageIssue(againstFile, currentFile)
+		res = append(res, issue)
+	}
+
+	for ruleName, optionName := range map[string]string{
+		ruleFileSameGoPackage:   "go_package",
+		ruleFileSameJavaPackage: "java_package",
+	} {
+		againstOption, againstValue := fileOption(againstFile.Proto, optionName)
+		_, currentValue := fileOption(currentFile.Proto, optionName)
+		if againstValue == currentValue {
+			continue
+		}
+
+		pos := filePosition(againstFile.Proto)
+		if againstOption != nil {
+			pos = againstOption.Meta.Pos
+		}
+
+		issue := getFileChangedOptionIssue(ruleName, againstFile, optionName, againstValue, currentValue, pos)
+		res = append(res, issue)
+	}
+
+	return res
+}
+
+// fileOption returns the file option and its value without quotes, value is empty if the option is not set.
+func fileOption(proto *unordered.Proto, name string) (*parser.Option, string) {
+	for _, option := range proto.ProtoBody.Options {
+		if option.OptionName == name {
+			return option, strings.Trim(option.Constant, `"'`)
+		}
+	}
+
+	return nil, ""
+}
+
+// filePosition returns position of package statement or syntax of the file.
+func filePosition(proto *unordered.Proto) meta.Position {
+	if len(proto.ProtoBody.Packages) != 0 {
+		return proto.ProtoBody.Packages[0].Meta.Pos
+	}
+
+	if proto.Syntax != nil {
+		return proto.Syntax.Meta.Pos
+	}
+
+	return meta.Position{}
+}
+
 // ===== IMPORTS =====
 
 func (b *BreakingChecker) checkImports(againstImport Import) []IssueInfo {
 	res := make([]IssueInfo, 0)
 
-	_, ok := getImport(b.current, againstImport.PackageName, ImportPath(againstImport.Location))
+	// types can be moved between files of the same package, they are checked by fully-qualified names,
+	// so import of a file of the same package can be deleted
+	importedFile, ok := getFile(b.against, string(ConvertImportPath(againstImport.Location)))
+	if ok && importedFile.PackageName == againstImport.PackageName {
+		return res
+	}
+
+	_, ok = getImport(b.current, againstImport.PackageName, ImportPath(againstImport.Location))
 	if !ok {
 		issue := getImportDeletedIssue(againstImport)
 		res = append(res, issue)
@@ -96,20 +188,29 @@ func (b *BreakingChecker) checkService(againstService Service) []IssueInfo {
 			continue
 		}
 
-		// check RPCs
-
 		// check request
-		// TODO: check stream
+		if againstRPC.RPCRequest.IsStream != currentRPC.RPCRequest.IsStream {
+			issue := getRPCClientStreamingChangedIssue(againstService, againstRPC, currentRPC)
+			res = append(res, issue)
+		}
+
+		againstRequest, againstResponse := rpcTypes(b.againstTypes, againstService, againstRPC)
+		currentRequest, currentResponse := rpcTypes(b.currentTypes, currentService, currentRPC)
+		pos := rpcPosition(againstService, againstRPC.RPCName, againstService.Meta.Pos)
 
-		if againstRPC.RPCRequest.MessageType != currentRPC.RPCRequest.MessageType {
-			issue := getRPCRequestChangedTypeIssue(againstService, currentService, againstRPC, currentRPC)
+		if againstRequest != currentRequest {
+			issue := getRPCRequestChangedTypeIssue(againstService, againstRPC, againstRequest, currentRequest, pos)
 			res = append(res, issue)
 		}
 
-		// TODO: check stream
 		// check response
-		if againstRPC.RPCResponse.MessageType != currentRPC.RPCResponse.MessageType {
-			issue := getRPCResponseChangedTypeIssue(againstService, currentService, againstRPC, currentRPC)
+		if againstRPC.RPCResponse.IsStream != currentRPC.RPCResponse.IsStream {
+			issue := getRPCServerStreamingChangedIssue(againstService, againstRPC, currentRPC)
+			res = append(res, issue)
+		}
+
+		if againstResponse != currentResponse {
+			issue := getRPCResponseChangedTypeIssue(againstService, againstRPC, againstResponse, currentResponse, pos)
 			res = append(res, issue)
 		}
 
@@ -146,13 +247,38 @@ func (b *BreakingChecker) checkMessage(againstMessage Message) []IssueInfo {
 	for _, againstField := range againstMessage.MessageBody.Fields {
 		currentField, ok := searchField(currentMessage.MessageBody.Fields, againstField.FieldNumber)
 		if !ok {
+			if _, ok := searchMapField(currentMessage.MessageBody.Maps, againstField.FieldNumber); ok {
+				issue := getFieldChangedLabelIssue(
+					againstMessage, againstField.FieldNumber, againstField.FieldName,
+					fieldLabel(againstField), mapFieldLabel, againstField.Meta.Pos,
+				)
+				res = append(res, issue)
+				continue
+			}
+
 			issue := getFieldDeletedIssue(againstMessage, againstField)
 			res = append(res, issue)
+
+			res = append(res, checkFieldReserved(
+				againstMessage, currentMessage, againstField.FieldNumber, againstField.FieldName, againstField.Meta.Pos,
+			)...)
 			continue
 		}
 
-		if againstField.Type != currentField.Type {
-			issue := getFieldChangedTypeIssue(againstMessage, againstField, currentField)
+		againstType := fieldType(b.againstTypes, againstMessage, againstField.FieldNumber, againstField.Type)
+		currentType := fieldType(b.currentTypes, currentMessage, currentField.FieldNumber, currentField.Type)
+		if againstType != currentType {
+			pos := fieldPosition(againstMessage, againstField.FieldNumber, againstMessage.Meta.Pos)
+			issue := getFieldChangedTypeIssue(againstMessage, againstField, againstType, currentType, pos)
+			res = append(res, issue)
+			continue
+		}
+
+		if againstField.IsRepeated != currentField.IsRepeated {
+			issue := getFieldChangedLabelIssue(
+				againstMessage, againstField.FieldNumber, againstField.FieldName,
+				fieldLabel(againstField), fieldLabel(currentField), againstField.Meta.Pos,
+			)
 			res = append(res, issue)
 			continue
 		}
@@ -165,11 +291,152 @@ func (b *BreakingChecker) checkMessage(againstMessage Message) []IssueInfo {
 			issue := getFieldBecameNotOptional(againstMessage, againstField)
 			res = append(res, issue)
 		}
+
+		res = append(res, checkFieldName(
+			againstMessage, againstField.FieldNumber,
+			againstField.FieldName, currentField.FieldName,
+			againstField.FieldOptions, currentField.FieldOptions,
+			againstField.Meta.Pos,
+		)...)
+	}
+
+	// check map fields
+	for _, againstMap := range againstMessage.MessageBody.Maps {
+		currentMap, ok := searchMapField(currentMessage.MessageBody.Maps, againstMap.FieldNumber)
+		if !ok {
+			if currentField, ok := searchField(currentMessage.MessageBody.Fields, againstMap.FieldNumber); ok {
+				issue := getFieldChangedLabelIssue(
+					againstMessage, againstMap.FieldNumber, againstMap.MapName,
+					mapFieldLabel, fieldLabel(currentField), againstMap.Meta.Pos,
+				)
+				res = append(res, issue)
+				continue
+			}
+
+			issue := getMapFieldDeletedIssue(againstMessage, againstMap)
+			res = append(res, issue)
+
+			res = append(res, checkFieldReserved(
+				againstMessage, currentMessage, againstMap.FieldNumber, againstMap.MapName, againstMap.Meta.Pos,
+			)...)
+			continue
+		}
+
+		if againstMap.KeyType != currentMap.KeyType {
+			issue := getMapFieldChangedKeyTypeIssue(againstMessage, againstMap, currentMap)
+			res = append(res, issue)
+		}
+
+		againstType := fieldType(b.againstTypes, againstMessage, againstMap.FieldNumber, againstMap.Type)
+		currentType := fieldType(b.currentTypes, currentMessage, currentMap.FieldNumber, currentMap.Type)
+		if againstType != currentType {
+			pos := fieldPosition(againstMessage, againstMap.FieldNumber, againstMap.Meta.Pos)
+			issue := getMapFieldChangedValueTypeIssue(againstMessage, againstMap, againstType, currentType, pos)
+			res = append(res, issue)
+		}
+
+		res = append(res, checkFieldName(
+			againstMessage, againstMap.FieldNumber,
+			againstMap.MapName, currentMap.MapName,
+			againstMap.FieldOptions, currentMap.FieldOptions,
+			againstMap.Meta.Pos,
+		)...)
+	}
+
+	res = append(res, checkReservedReused(againstMessage, currentMessage)...)
+
+	return res
+}
+
+// checkFieldReserved checks that number and name of the field deleted from the message were reserved.
+// Number or name which is still used in the message (e.g. field moved to oneof) doesn't have to be reserved.
+func checkFieldReserved(againstMessage, currentMessage Message, number, name string, pos meta.Position) []IssueInfo {
+	res := make([]IssueInfo, 0)
+
+	numberUsed, nameUsed := false, false
+	for _, field := range messageFields(currentMessage.Message) {
+		numberUsed = numberUsed || field.number == number
+		nameUsed = nameUsed || field.name == name
+	}
+
+	if numberUsed {
+		return res
+	}
+
+	reserves := currentMessage.MessageBody.Reserves
+
+	if !isNumberReserved(reserves, number) {
+		issue := getFieldDeletedNotReservedIssue(
+			ruleFieldNoDeleteUnlessNumberReserved, againstMessage, number, name, "number", pos,
+		)
+		res = append(res, issue)
+	}
+
+	if !nameUsed && !isNameReserved(reserves, name) {
+		issue := getFieldDeletedNotReservedIssue(
+			ruleFieldNoDeleteUnlessNameReserved, againstMessage, number, name, "name", pos,
+		)
+		res = append(res, issue)
+	}
+
+	return res
+}
+
+// checkReservedReused checks that fields of the current message don't use numbers and names
+// reserved in the against message.
+func checkReservedReused(againstMessage, currentMessage Message) []IssueInfo {
+	res := make([]IssueInfo, 0)
+
+	reserves := againstMessage.MessageBody.Reserves
+	if len(reserves) == 0 {
+		return res
+	}
+
+	for _, field := range messageFields(currentMessage.Message) {
+		if isNumberReserved(reserves, field.number) {
+			issue := getReservedNumberReusedIssue(currentMessage, field)
+			res = append(res, issue)
+		}
+
+		if isNameReserved(reserves, field.name) {
+			issue := getReservedNameReusedIssue(currentMessage, field)
+			res = append(res, issue)
+		}
 	}
 
 	return res
 }
 
+// checkFieldName checks that the field still has the same JSON name,
+// it is changed by renaming of the field without json_name option or by changing of json_name option.
+func checkFieldName(
+	againstMessage Message,
+	number, againstName, currentName string,
+	againstOptions, currentOptions []*parser.FieldOption,
+	pos meta.Position,
+) []IssueInfo {
+	res := make([]IssueInfo, 0)
+
+	againstJSONName := fieldJSONName(againstName, againstOptions)
+	currentJSONName := fieldJSONName(currentName, currentOptions)
+	if againstJSONName == currentJSONName {
+		return res
+	}
+
+	if againstName != currentName {
+		issue := getFieldRenamedIssue(
+			againstMessage, number, againstName, currentName, againstJSONName, currentJSONName, pos,
+		)
+		res = append(res, issue)
+		return res
+	}
+
+	issue := getFieldChangedJSONNameIssue(againstMessage, number, againstName, againstJSONName, currentJSONName, pos)
+	res = append(res, issue)
+
+	return res
+}
+
 // ===== OneOf =====
 
 func (b *BreakingChecker) checkOneOf(againstOneOf OneOf) []IssueInfo {
@@ -182,17 +449,25 @@ func (b *BreakingChecker) checkOneOf(againstOneOf OneOf) []IssueInfo {
 		return res
 	}
 
+	againstMessage := oneOfMessage(b.against, againstOneOf)
+	currentMessage := oneOfMessage(b.current, currentOneOf)
+
 	// check fields
 	for _, againstField := range againstOneOf.OneofFields {
 		currentField, ok := searchOneOfField(currentOneOf.OneofFields, againstField.FieldNumber)
 		if !ok {
 			issue := getOneOfFieldDeletedIssue(againstOneOf, againstField)
 			res = append(res, issue)
+
+			res = append(res, b.checkOneOfFieldReserved(againstOneOf, againstField)...)
 			continue
 		}
 
-		if againstField.Type != currentField.Type {
-			issue := getOneOfFieldChangedTypeIssue(againstOneOf, againstField, currentField)
+		againstType := fieldType(b.againstTypes, againstMessage, againstField.FieldNumber, againstField.Type)
+		currentType := fieldType(b.currentTypes, currentMessage, currentField.FieldNumber, currentField.Type)
+		if againstType != currentType {
+			pos := fieldPosition(againstMessage, againstField.FieldNumber, againstField.Meta.Pos)
+			issue := getOneOfFieldChangedTypeIssue(againstOneOf, againstField, againstType, currentType, pos)
 			res = append(res, issue)
 			continue
 		}
@@ -201,6 +476,44 @@ func (b *BreakingChecker) checkOneOf(againstOneOf OneOf) []IssueInfo {
 	return res
 }
 
+// checkOneOfFieldReserved checks that number and name of the field deleted from the oneof
+// were reserved in the message of the oneof.
+func (b *BreakingChecker) checkOneOfFieldReserved(againstOneOf OneOf, againstField *parser.OneofField) []IssueInfo {
+	messagePath := oneOfMessagePath(againstOneOf)
+
+	againstMessage, ok := getMessage(b.against, againstOneOf.PackageName, messagePath)
+	if !ok {
+		return nil
+	}
+
+	currentMessage, ok := getMessage(b.current, againstOneOf.PackageName, messagePath)
+	if !ok {
+		// message was deleted, it is reported by message check
+		return nil
+	}
+
+	return checkFieldReserved(
+		againstMessage, currentMessage, againstField.FieldNumber, againstField.FieldName, againstField.Meta.Pos,
+	)
+}
+
+// oneOfMessagePath returns path of the message which contains the oneof.
+func oneOfMessagePath(oneOf OneOf) string {
+	return oneOf.OneOfPath[:max(strings.LastIndex(oneOf.OneOfPath, "."), 0)]
+}
+
+// oneOfMessage returns the message which contains the oneof.
+func oneOfMessage(source ProtoData, oneOf OneOf) Message {
+	messagePath := oneOfMessagePath(oneOf)
+
+	message, ok := getMessage(source, oneOf.PackageName, messagePath)
+	if !ok {
+		return Message{MessagePath: messagePath, PackageName: oneOf.PackageName}
+	}
+
+	return message
+}
+
 // ===== ENUM =====
 
 func (b *BreakingChecker) checkEnum(againstEnum Enum) []IssueInfo {
@@ -231,8 +544,61 @@ func (b *BreakingChecker) checkEnum(againstEnum Enum) []IssueInfo {
 	return res
 }
 
+// ===== TYPES =====
+
+// typeNames returns fully-qualified names of all messages and enums.
+func typeNames(data ProtoData) map[string]struct{} {
+	res := make(map[string]struct{})
+
+	for packageName, collection := range data {
+		for messagePath := range collection.Messages {
+			res[getProtoEntityPath(string(packageName), messagePath)] = struct{}{}
+		}
+
+		for enumPath := range collection.Enums {
+			res[getProtoEntityPath(string(packageName), enumPath)] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// resolveType returns fully-qualified name of the type referenced from the scope (package and message path).
+// Like protoc, the innermost scope which contains the type is used, e.g. Bar referenced from pkg.Foo
+// is pkg.Foo.Bar, pkg.Bar or Bar. Scalar and unknown types are returned as is.
+func resolveType(types map[string]struct{}, scope, typeName string) string {
+	if strings.HasPrefix(typeName, ".") {
+		return typeName[1:]
+	}
+
+	var scopeParts []string
+	if scope != "" {
+		scopeParts = strings.Split(scope, ".")
+	}
+
+	for i := len(scopeParts); i >= 0; i-- {
+		fullName := getProtoEntityPath(strings.Join(scopeParts[:i], "."), typeName)
+		if _, ok := types[fullName]; ok {
+			return fullName
+		}
+	}
+
+	return typeName
+}
+
 // ===== utils =====
 
+// getFile searches checked file by path in all packages, because package of the file can be changed.
+func getFile(source ProtoData, protoFilePath string) (File, bool) {
+	for _, collection := range source {
+		if file, ok := collection.Files[protoFilePath]; ok {
+			return file, true
+		}
+	}
+
+	return File{}, false
+}
+
 func getImport(source ProtoData, packageName PackageName, importPath ImportPath) (Import, bool) {
 	collection, ok := source[packageName]
 	if !ok {
@@ -313,6 +679,16 @@ func searchField(source []*parser.Field, number string) (*parser.Field, bool) {
 	return nil, false
 }
 
+func searchMapField(source []*parser.MapField, number string) (*parser.MapField, bool) {
+	for _, field := range source {
+		if field.FieldNumber == number {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
 func searchOneOfField(source []*parser.OneofField, number string) (*parser.OneofField, bool) {
 	for _, field := range source {
 		if field.FieldNumber == number {
@@ -333,31 +709,233 @@ func searchEnumField(source []*parser.EnumField, number string) (*parser.EnumFie
 	return nil, false
 }
 
+const mapFieldLabel = "map"
+
+// fieldLabel returns label of the field how it is written in proto file, singular fields have no label.
+func fieldLabel(field *parser.Field) string {
+	switch {
+	case field.IsRepeated:
+		return "repeated"
+	case field.IsRequired:
+		return "required"
+	case field.IsOptional:
+		return "optional"
+	default:
+		return "singular"
+	}
+}
+
+// fieldRef is a field of any kind (normal, map or oneof one) of a message.
+type fieldRef struct {
+	number string
+	name   string
+	pos    meta.Position
+}
+
+// messageFields returns all fields of the message including map fields and fields of oneofs.
+func messageFields(message *unordered.Message) []fieldRef {
+	var res []fieldRef
+
+	for _, field := range message.MessageBody.Fields {
+		res = append(res, fieldRef{number: field.FieldNumber, name: field.FieldName, pos: field.Meta.Pos})
+	}
+
+	for _, field := range message.MessageBody.Maps {
+		res = append(res, fieldRef{number: field.FieldNumber, name: field.MapName, pos: field.Meta.Pos})
+	}
+
+	for _, oneOf := range message.MessageBody.Oneofs {
+		for _, field := range oneOf.OneofFields {
+			res = append(res, fieldRef{number: field.FieldNumber, name: field.FieldName, pos: field.Meta.Pos})
+		}
+	}
+
+	return res
+}
+
+// parseFieldNumber parses decimal, hex and octal field numbers.
+func parseFieldNumber(number string) (int64, bool) {
+	if number == "max" {
+		return maxFieldNumber, true
+	}
+
+	res, err := strconv.ParseInt(number, 0, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return res, true
+}
+
+func isNumberReserved(reserves []*parser.Reserved, number string) bool {
+	n, ok := parseFieldNumber(number)
+	if !ok {
+		return false
+	}
+
+	for _, reserved := range reserves {
+		for _, r := range reserved.Ranges {
+			begin, ok := parseFieldNumber(r.Begin)
+			if !ok {
+				continue
+			}
+
+			end := begin
+			if r.End != "" {
+				if end, ok = parseFieldNumber(r.End); !ok {
+					continue
+				}
+			}
+
+			if n >= begin && n <= end {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isNameReserved(reserves []*parser.Reserved, name string) bool {
+	for _, reserved := range reserves {
+		for _, fieldName := range reserved.FieldNames {
+			if strings.Trim(fieldName, `"'`) == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fieldJSONName returns JSON name of the field: value of json_name option
+// or lowerCamelCase name the same way as protoc generates it.
+func fieldJSONName(name string, options []*parser.FieldOption) string {
+	for _, option := range options {
+		if option.OptionName == "json_name" {
+			return strings.Trim(option.Constant, `"'`)
+		}
+	}
+
+	var (
+		b              strings.Builder
+		capitalizeNext bool
+	)
+
+	for _, r := range name {
+		switch {
+		case r == '_':
+			capitalizeNext = true
+		case capitalizeNext && r >= 'a' && r <= 'z':
+			b.WriteRune(r - 'a' + 'A')
+			capitalizeNext = false
+		default:
+			b.WriteRune(r)
+			capitalizeNext = false
+		}
+	}
+
+	return b.String()
+}
+
 // issues
 
-func buildBreakingCheckIssue(path, message string, pos meta.Position) IssueInfo {
+func buildBreakingIssue(ruleName, path, message, hint string, scope IssueScope, pos meta.Position) IssueInfo {
 	issue := Issue{
 		Position:   pos,
 		SourceName: "",
 		Message:    message,
-		RuleName:   breakingCheckRuleName,
+		RuleName:   ruleName,
+		Severity:   SeverityError,
+		Hint:       hint,
 	}
 	return IssueInfo{
 		Issue: issue,
 		Path:  path,
+		Scope: scope,
 	}
 }
 
+func packageScope(packageName PackageName) IssueScope {
+	return IssueScope{Package: string(packageName)}
+}
+
+func serviceScope(service Service) IssueScope {
+	return IssueScope{Package: string(service.PackageName), Service: service.ServiceName}
+}
+
+func messageScope(message Message) IssueScope {
+	return IssueScope{Package: string(message.PackageName), Message: message.MessagePath}
+}
+
+// parentMessageScope returns scope of the message containing the element with the path,
+// e.g. oneof or nested enum, elements declared on file level have package scope.
+func parentMessageScope(packageName PackageName, path string) IssueScope {
+	i := strings.LastIndex(path, ".")
+	if i == -1 {
+		return packageScope(packageName)
+	}
+
+	return IssueScope{Package: string(packageName), Message: path[:i]}
+}
+
+func getFileDeletedIssue(againstFile File) IssueInfo {
+	message := fmt.Sprintf("Previously present file \"%s\" was deleted.", againstFile.ProtoFilePath)
+	hint := fmt.Sprintf(
+		"Add file \"%s\" back and mark its elements deprecated instead of deleting it.", againstFile.ProtoFilePath,
+	)
+	return buildBreakingIssue(
+		ruleFileNoDelete, againstFile.ProtoFilePath, message, hint,
+		packageScope(againstFile.PackageName), filePosition(againstFile.Proto),
+	)
+}
+
+func getFileChangedPackageIssue(againstFile, currentFile File) IssueInfo {
+	message := fmt.Sprintf(
+		"File \"%s\" changed package from \"%s\" to \"%s\".",
+		againstFile.ProtoFilePath, againstFile.PackageName, currentFile.PackageName,
+	)
+	hint := fmt.Sprintf(
+		"Restore package \"%s\" and add elements of package \"%s\" to a new file.",
+		againstFile.PackageName, currentFile.PackageName,
+	)
+	return buildBreakingIssue(
+		ruleFileSamePackage, againstFile.ProtoFilePath, message, hint,
+		packageScope(againstFile.PackageName), filePosition(againstFile.Proto),
+	)
+}
+
+func getFileChangedOptionIssue(
+	ruleName string, againstFile File, optionName, againstValue, currentValue string, pos meta.Position,
+) IssueInfo {
+	message := fmt.Sprintf(
+		"File \"%s\" changed option \"%s\" from \"%s\" to \"%s\".",
+		againstFile.ProtoFilePath, optionName, againstValue, currentValue,
+	)
+	hint := fmt.Sprintf("Restore option \"%s\" to \"%s\".", optionName, againstValue)
+	return buildBreakingIssue(
+		ruleName, againstFile.ProtoFilePath, message, hint, packageScope(againstFile.PackageName), pos,
+	)
+}
+
 func getImportDeletedIssue(againstImport Import) IssueInfo {
 	message := fmt.Sprintf("Previously import \"%s\" was deleted.\n", againstImport.Location)
-	return buildBreakingCheckIssue(againstImport.ProtoFilePath, message, againstImport.Meta.Pos)
+	hint := fmt.Sprintf("Add import %s back.", againstImport.Location)
+	return buildBreakingIssue(
+		ruleImportNoDelete, againstImport.ProtoFilePath, message, hint,
+		packageScope(againstImport.PackageName), againstImport.Meta.Pos,
+	)
 }
 
 func getServiceDeletedIssue(againstService Service) IssueInfo {
 	message := fmt.Sprintf(
 		"Previously present service \"%s\" was deleted from file.", againstService.ServiceName,
 	)
-	return buildBreakingCheckIssue(againstService.ProtoFilePath, message, againstService.Meta.Pos)
+	hint := fmt.Sprintf("Add service \"%s\" back and mark it deprecated.", againstService.ServiceName)
+	return buildBreakingIssue(
+		ruleServiceNoDelete, againstService.ProtoFilePath, message, hint,
+		serviceScope(againstService), againstService.Meta.Pos,
+	)
 }
 
 func getRPCDeletedIssue(againstService Service, againstRPC *parser.RPC) IssueInfo {
@@ -365,46 +943,86 @@ func getRPCDeletedIssue(againstService Service, againstRPC *parser.RPC) IssueInf
 		"Previously present RPC \"%s\" on service \"%s\" was deleted.",
 		againstRPC.RPCName, againstService.ServiceName,
 	)
-	return buildBreakingCheckIssue(againstService.ProtoFilePath, message, againstService.Meta.Pos)
+	hint := fmt.Sprintf("Add the RPC \"%s\" back and mark it deprecated.", againstRPC.RPCName)
+	return buildBreakingIssue(
+		ruleRPCNoDelete, againstService.ProtoFilePath, message, hint,
+		serviceScope(againstService), againstService.Meta.Pos,
+	)
 }
 
 func getRPCRequestChangedTypeIssue(
-	againstService, current Service, againstRPC, currentRPC *parser.RPC,
+	againstService Service, againstRPC *parser.RPC, againstType, currentType string, pos meta.Position,
 ) IssueInfo {
-	againstParser := InstructionParser{SourcePkgName: againstService.PackageName}
-	currentParser := InstructionParser{SourcePkgName: current.PackageName}
-
 	message := fmt.Sprintf(
 		"RPC \"%s\" on service \"%s\" changed request type "+
 			"from \"%s\" to \"%s\".",
-		againstRPC.RPCName, againstService.ServiceName,
-		againstParser.Parse(againstRPC.RPCRequest.MessageType).GetFullName(),
-		currentParser.Parse(currentRPC.RPCRequest.MessageType).GetFullName(),
+		againstRPC.RPCName, againstService.ServiceName, againstType, currentType,
+	)
+	hint := fmt.Sprintf(
+		"Restore request type \"%s\" of RPC \"%s\" and add a new RPC with request type \"%s\".",
+		againstType, againstRPC.RPCName, currentType,
+	)
+	return buildBreakingIssue(
+		ruleRPCSameRequestType, againstService.ProtoFilePath, message, hint, serviceScope(againstService), pos,
 	)
-	return buildBreakingCheckIssue(againstService.ProtoFilePath, message, againstService.Meta.Pos)
 }
 
 func getRPCResponseChangedTypeIssue(
-	againstService, current Service, againstRPC, currentRPC *parser.RPC,
+	againstService Service, againstRPC *parser.RPC, againstType, currentType string, pos meta.Position,
 ) IssueInfo {
-	againstParser := InstructionParser{SourcePkgName: againstService.PackageName}
-	currentParser := InstructionParser{SourcePkgName: current.PackageName}
-
 	message := fmt.Sprintf(
 		"RPC \"%s\" on service \"%s\" changed response type "+
 			"from \"%s\" to \"%s\".",
+		againstRPC.RPCName, againstService.ServiceName, againstType, currentType,
+	)
+	hint := fmt.Sprintf(
+		"Restore response type \"%s\" of RPC \"%s\" and add a new RPC with response type \"%s\".",
+		againstType, againstRPC.RPCName, currentType,
+	)
+	return buildBreakingIssue(
+		ruleRPCSameResponseType, againstService.ProtoFilePath, message, hint, serviceScope(againstService), pos,
+	)
+}
+
+func getRPCClientStreamingChangedIssue(againstService Service, againstRPC, currentRPC *parser.RPC) IssueInfo {
+	message := fmt.Sprintf(
+		"RPC \"%s\" on service \"%s\" changed client streaming from \"%t\" to \"%t\".",
 		againstRPC.RPCName, againstService.ServiceName,
-		againstParser.Parse(againstRPC.RPCResponse.MessageType).GetFullName(),
-		currentParser.Parse(currentRPC.RPCResponse.MessageType).GetFullName(),
+		againstRPC.RPCRequest.IsStream, currentRPC.RPCRequest.IsStream,
+	)
+	hint := fmt.Sprintf(
+		"Restore client streaming of RPC \"%s\" and add a new RPC with the changed streaming.", againstRPC.RPCName,
+	)
+	return buildBreakingIssue(
+		ruleRPCSameClientStreaming, againstService.ProtoFilePath, message, hint,
+		serviceScope(againstService), againstRPC.Meta.Pos,
+	)
+}
+
+func getRPCServerStreamingChangedIssue(againstService Service, againstRPC, currentRPC *parser.RPC) IssueInfo {
+	message := fmt.Sprintf(
+		"RPC \"%s\" on service \"%s\" changed server streaming from \"%t\" to \"%t\".",
+		againstRPC.RPCName, againstService.ServiceName,
+		againstRPC.RPCResponse.IsStream, currentRPC.RPCResponse.IsStream,
+	)
+	hint := fmt.Sprintf(
+		"Restore server streaming of RPC \"%s\" and add a new RPC with the changed streaming.", againstRPC.RPCName,
+	)
+	return buildBreakingIssue(
+		ruleRPCSameServerStreaming, againstService.ProtoFilePath, message, hint,
+		serviceScope(againstService), againstRPC.Meta.Pos,
 	)
-	return buildBreakingCheckIssue(againstService.ProtoFilePath, message, againstService.Meta.Pos)
 }
 
 func getMessageDeletedIssue(againstMessage Message) IssueInfo {
 	message := fmt.Sprintf(
 		"Previously present message \"%s\" was deleted from file.\n", againstMessage.MessagePath,
 	)
-	return buildBreakingCheckIssue(againstMessage.ProtoFilePath, message, againstMessage.Meta.Pos)
+	hint := fmt.Sprintf("Add message \"%s\" back and mark it deprecated.", againstMessage.MessagePath)
+	return buildBreakingIssue(
+		ruleMessageNoDelete, againstMessage.ProtoFilePath, message, hint,
+		messageScope(againstMessage), againstMessage.Meta.Pos,
+	)
 }
 
 func getFieldDeletedIssue(againstMessage Message, againstField *parser.Field) IssueInfo {
@@ -412,16 +1030,164 @@ func getFieldDeletedIssue(againstMessage Message, againstField *parser.Field) Is
 		"on message \"%s\" was deleted.",
 		againstField.FieldNumber, againstField.FieldName, againstMessage.MessagePath,
 	)
-	return buildBreakingCheckIssue(againstMessage.ProtoFilePath, message, againstMessage.Meta.Pos)
+	return buildBreakingIssue(
+		ruleFieldNoDelete, againstMessage.ProtoFilePath, message,
+		fieldDeletedHint(againstField.FieldNumber, againstField.FieldName),
+		messageScope(againstMessage), againstMessage.Meta.Pos,
+	)
+}
+
+func getMapFieldDeletedIssue(againstMessage Message, againstMap *parser.MapField) IssueInfo {
+	message := fmt.Sprintf("Previously present field \"%s\" with name \"%s\" "+
+		"on message \"%s\" was deleted.",
+		againstMap.FieldNumber, againstMap.MapName, againstMessage.MessagePath,
+	)
+	return buildBreakingIssue(
+		ruleFieldNoDelete, againstMessage.ProtoFilePath, message,
+		fieldDeletedHint(againstMap.FieldNumber, againstMap.MapName),
+		messageScope(againstMessage), againstMessage.Meta.Pos,
+	)
 }
 
-func getFieldChangedTypeIssue(againstMessage Message, againstField, currentField *parser.Field) IssueInfo {
+func fieldDeletedHint(number, name string) string {
+	return fmt.Sprintf(
+		"Add field \"%s\" back and mark it deprecated, or reserve field number %s and name \"%s\".",
+		name, number, name,
+	)
+}
+
+func getFieldDeletedNotReservedIssue(
+	ruleName string, againstMessage Message, number, name, reservedKind string, pos meta.Position,
+) IssueInfo {
+	message := fmt.Sprintf("Previously present field \"%s\" with name \"%s\" "+
+		"on message \"%s\" was deleted without reserving the %s.",
+		number, name, againstMessage.MessagePath, reservedKind,
+	)
+	hint := fmt.Sprintf("Reserve field number %s on message \"%s\".", number, againstMessage.MessagePath)
+	if ruleName == ruleFieldNoDeleteUnlessNameReserved {
+		hint = fmt.Sprintf("Reserve field name \"%s\" on message \"%s\".", name, againstMessage.MessagePath)
+	}
+	return buildBreakingIssue(ruleName, againstMessage.ProtoFilePath, message, hint, messageScope(againstMessage), pos)
+}
+
+func getReservedNumberReusedIssue(currentMessage Message, field fieldRef) IssueInfo {
+	message := fmt.Sprintf("Field \"%s\" with name \"%s\" "+
+		"on message \"%s\" uses previously reserved number.",
+		field.number, field.name, currentMessage.MessagePath,
+	)
+	hint := fmt.Sprintf("Use a new number for field \"%s\" instead of reserved number %s.", field.name, field.number)
+	return buildBreakingIssue(
+		ruleReservedNumberNoReuse, currentMessage.ProtoFilePath, message, hint, messageScope(currentMessage), field.pos,
+	)
+}
+
+func getReservedNameReusedIssue(currentMessage Message, field fieldRef) IssueInfo {
+	message := fmt.Sprintf("Field \"%s\" with name \"%s\" "+
+		"on message \"%s\" uses previously reserved name.",
+		field.number, field.name, currentMessage.MessagePath,
+	)
+	hint := fmt.Sprintf(
+		"Rename field \"%s\" to a name which is not reserved, or remove \"%s\" from reserved names.",
+		field.name, field.name,
+	)
+	return buildBreakingIssue(
+		ruleReservedNameNoReuse, currentMessage.ProtoFilePath, message, hint, messageScope(currentMessage), field.pos,
+	)
+}
+
+func getFieldChangedLabelIssue(
+	againstMessage Message, number, name, againstLabel, currentLabel string, pos meta.Position,
+) IssueInfo {
+	message := fmt.Sprintf("Field \"%s\" with name \"%s\" "+
+		"on message \"%s\" changed label from \"%s\" to \"%s\".",
+		number, name, againstMessage.MessagePath, againstLabel, currentLabel,
+	)
+	hint := fmt.Sprintf(
+		"Restore label \"%s\" of field \"%s\" and add a new field with label \"%s\" instead.",
+		againstLabel, name, currentLabel,
+	)
+	return buildBreakingIssue(
+		ruleFieldSameLabel, againstMessage.ProtoFilePath, message, hint, messageScope(againstMessage), pos,
+	)
+}
+
+func getMapFieldChangedKeyTypeIssue(againstMessage Message, againstMap, currentMap *parser.MapField) IssueInfo {
+	message := fmt.Sprintf("Map field \"%s\" with name \"%s\" "+
+		"on message \"%s\" changed key type from \"%s\" to \"%s\".",
+		againstMap.FieldNumber, againstMap.MapName, againstMessage.MessagePath,
+		againstMap.KeyType, currentMap.KeyType,
+	)
+	return buildBreakingIssue(
+		ruleFieldSameMapKeyType, againstMessage.ProtoFilePath, message,
+		fieldChangedTypeHint(againstMap.MapName, "key type", againstMap.KeyType, currentMap.KeyType),
+		messageScope(againstMessage), againstMap.Meta.Pos,
+	)
+}
+
+func getMapFieldChangedValueTypeIssue(
+	againstMessage Message, againstMap *parser.MapField, againstType, currentType string, pos meta.Position,
+) IssueInfo {
+	message := fmt.Sprintf("Map field \"%s\" with name \"%s\" "+
+		"on message \"%s\" changed value type from \"%s\" to \"%s\".",
+		againstMap.FieldNumber, againstMap.MapName, againstMessage.MessagePath,
+		againstType, currentType,
+	)
+	return buildBreakingIssue(
+		ruleFieldSameMapValueType, againstMessage.ProtoFilePath, message,
+		fieldChangedTypeHint(againstMap.MapName, "value type", againstType, currentType),
+		messageScope(againstMessage), pos,
+	)
+}
+
+func getFieldRenamedIssue(
+	againstMessage Message, number, againstName, currentName, againstJSONName, currentJSONName string, pos meta.Position,
+) IssueInfo {
+	message := fmt.Sprintf("Field \"%s\" on message \"%s\" changed name from \"%s\" to \"%s\", "+
+		"so its JSON name changed from \"%s\" to \"%s\".",
+		number, againstMessage.MessagePath, againstName, currentName, againstJSONName, currentJSONName,
+	)
+	hint := fmt.Sprintf(
+		"Restore name \"%s\" of field %s or set json_name = \"%s\" to keep its JSON name.",
+		againstName, number, againstJSONName,
+	)
+	return buildBreakingIssue(
+		ruleFieldSameName, againstMessage.ProtoFilePath, message, hint, messageScope(againstMessage), pos,
+	)
+}
+
+func getFieldChangedJSONNameIssue(
+	againstMessage Message, number, name, againstJSONName, currentJSONName string, pos meta.Position,
+) IssueInfo {
+	message := fmt.Sprintf("Field \"%s\" with name \"%s\" "+
+		"on message \"%s\" changed JSON name from \"%s\" to \"%s\".",
+		number, name, againstMessage.MessagePath, againstJSONName, currentJSONName,
+	)
+	hint := fmt.Sprintf("Restore json_name = \"%s\" of field \"%s\".", againstJSONName, name)
+	return buildBreakingIssue(
+		ruleFieldSameJSONName, againstMessage.ProtoFilePath, message, hint, messageScope(againstMessage), pos,
+	)
+}
+
+func getFieldChangedTypeIssue(
+	againstMessage Message, againstField *parser.Field, againstType, currentType string, pos meta.Position,
+) IssueInfo {
 	message := fmt.Sprintf("Field \"%s\" with name \"%s\" "+
 		"on message \"%s\" changed type from \"%s\" to \"%s\".",
 		againstField.FieldNumber, againstField.FieldName, againstMessage.MessageName,
-		againstField.Type, currentField.Type,
+		againstType, currentType,
+	)
+	return buildBreakingIssue(
+		ruleFieldSameType, againstMessage.ProtoFilePath, message,
+		fieldChangedTypeHint(againstField.FieldName, "type", againstType, currentType),
+		messageScope(againstMessage), pos,
+	)
+}
+
+func fieldChangedTypeHint(name, kind, againstType, currentType string) string {
+	return fmt.Sprintf(
+		"Restore %s \"%s\" of field \"%s\" and add a new field with %s \"%s\" instead.",
+		kind, againstType, name, kind, currentType,
 	)
-	return buildBreakingCheckIssue(againstMessage.ProtoFilePath, message, againstMessage.Meta.Pos)
 }
 
 func getFieldBecameOptional(againstMessage Message, againstField *parser.Field) IssueInfo {
@@ -429,7 +1195,11 @@ func getFieldBecameOptional(againstMessage Message, againstField *parser.Field)
 		"on message \"%s\" became optional",
 		againstField.FieldNumber, againstField.FieldName, againstMessage.MessageName,
 	)
-	return buildBreakingCheckIssue(againstMessage.ProtoFilePath, message, againstMessage.Meta.Pos)
+	hint := fmt.Sprintf("Remove optional label of field \"%s\".", againstField.FieldName)
+	return buildBreakingIssue(
+		ruleFieldSameCardinality, againstMessage.ProtoFilePath, message, hint,
+		messageScope(againstMessage), againstMessage.Meta.Pos,
+	)
 }
 
 func getFieldBecameNotOptional(againstMessage Message, againstField *parser.Field) IssueInfo {
@@ -437,14 +1207,22 @@ func getFieldBecameNotOptional(againstMessage Message, againstField *parser.Fiel
 		"on message \"%s\" became not optional",
 		againstField.FieldNumber, againstField.FieldName, againstMessage.MessageName,
 	)
-	return buildBreakingCheckIssue(againstMessage.ProtoFilePath, message, againstMessage.Meta.Pos)
+	hint := fmt.Sprintf("Add optional label of field \"%s\" back.", againstField.FieldName)
+	return buildBreakingIssue(
+		ruleFieldSameCardinality, againstMessage.ProtoFilePath, message, hint,
+		messageScope(againstMessage), againstMessage.Meta.Pos,
+	)
 }
 
 func getOneOfDeletedIssue(againstOneOf OneOf) IssueInfo {
 	message := fmt.Sprintf("Previously present oneof \"%s\" was deleted.",
 		againstOneOf.OneOfPath,
 	)
-	return buildBreakingCheckIssue(againstOneOf.ProtoFilePath, message, againstOneOf.Meta.Pos)
+	hint := fmt.Sprintf("Add oneof \"%s\" back and mark its fields deprecated.", againstOneOf.OneofName)
+	return buildBreakingIssue(
+		ruleOneOfNoDelete, againstOneOf.ProtoFilePath, message, hint,
+		parentMessageScope(againstOneOf.PackageName, againstOneOf.OneOfPath), againstOneOf.Meta.Pos,
+	)
 }
 
 func getOneOfFieldDeletedIssue(againstOneOf OneOf, againstField *parser.OneofField) IssueInfo {
@@ -452,32 +1230,51 @@ func getOneOfFieldDeletedIssue(againstOneOf OneOf, againstField *parser.OneofFie
 		"on OneOf \"%s\" was deleted.",
 		againstField.FieldNumber, againstField.FieldName, againstOneOf.OneOfPath,
 	)
-	return buildBreakingCheckIssue(againstOneOf.ProtoFilePath, message, againstField.Meta.Pos)
+	return buildBreakingIssue(
+		ruleOneOfFieldNoDelete, againstOneOf.ProtoFilePath, message,
+		fieldDeletedHint(againstField.FieldNumber, againstField.FieldName),
+		parentMessageScope(againstOneOf.PackageName, againstOneOf.OneOfPath), againstField.Meta.Pos,
+	)
 }
 
 func getOneOfFieldChangedTypeIssue(
-	againstOneOf OneOf, againstOneOfField, currentOneOfField *parser.OneofField,
+	againstOneOf OneOf, againstOneOfField *parser.OneofField, againstType, currentType string, pos meta.Position,
 ) IssueInfo {
 	message := fmt.Sprintf("Field \"%s\" with name \"%s\" "+
 		"on OneOf \"%s\" changed type from \"%s\" to \"%s\".",
 		againstOneOfField.FieldNumber, againstOneOfField.FieldName, againstOneOf.OneOfPath,
-		againstOneOfField.Type, currentOneOfField.Type,
+		againstType, currentType,
+	)
+	return buildBreakingIssue(
+		ruleOneOfFieldSameType, againstOneOf.ProtoFilePath, message,
+		fieldChangedTypeHint(againstOneOfField.FieldName, "type", againstType, currentType),
+		parentMessageScope(againstOneOf.PackageName, againstOneOf.OneOfPath), pos,
 	)
-	return buildBreakingCheckIssue(againstOneOf.ProtoFilePath, message, againstOneOfField.Meta.Pos)
 }
 
 func getEnumDeletedIssue(againstEnum Enum) IssueInfo {
 	message := fmt.Sprintf("Previously present enum \"%s\" was deleted from file.",
 		againstEnum.EnumPath,
 	)
-	return buildBreakingCheckIssue(againstEnum.ProtoFilePath, message, againstEnum.Meta.Pos)
+	hint := fmt.Sprintf("Add enum \"%s\" back and mark it deprecated.", againstEnum.EnumPath)
+	return buildBreakingIssue(
+		ruleEnumNoDelete, againstEnum.ProtoFilePath, message, hint,
+		parentMessageScope(againstEnum.PackageName, againstEnum.EnumPath), againstEnum.Meta.Pos,
+	)
 }
 
 func getEnumFieldDeletedIssue(againstEnum Enum, againstField *parser.EnumField) IssueInfo {
 	message := fmt.Sprintf("Previously present enum value \"%s\" on enum \"%s\" was deleted.",
 		againstField.Number, againstEnum.EnumPath,
 	)
-	return buildBreakingCheckIssue(againstEnum.ProtoFilePath, message, againstEnum.Meta.Pos)
+	hint := fmt.Sprintf(
+		"Add enum value \"%s\" back and mark it deprecated, or reserve number %s and name \"%s\".",
+		againstField.Ident, againstField.Number, againstField.Ident,
+	)
+	return buildBreakingIssue(
+		ruleEnumValueNoDelete, againstEnum.ProtoFilePath, message, hint,
+		parentMessageScope(againstEnum.PackageName, againstEnum.EnumPath), againstEnum.Meta.Pos,
+	)
 }
 
 func getEnumFieldRenamedIssue(againstEnum Enum, againstField, currentField *parser.EnumField) IssueInfo {
@@ -486,5 +1283,9 @@ func getEnumFieldRenamedIssue(againstEnum Enum, againstField, currentField *pars
 		againstField.Number, againstEnum.EnumPath,
 		againstField.Ident, currentField.Ident,
 	)
-	return buildBreakingCheckIssue(againstEnum.ProtoFilePath, message, againstEnum.Meta.Pos)
+	hint := fmt.Sprintf("Restore name \"%s\" of enum value %s.", againstField.Ident, againstField.Number)
+	return buildBreakingIssue(
+		ruleEnumValueSameName, againstEnum.ProtoFilePath, message, hint,
+		parentMessageScope(againstEnum.PackageName, againstEnum.EnumPath), againstEnum.Meta.Pos,
+	)
 }
@@ -2,12 +2,15 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
 	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
 )
 
 const (
@@ -19,7 +22,7 @@ const (
 func TestCore_BreakingCheck(t *testing.T) {
 	t.Parallel()
 
-	c := &Core{}
+	c := &Core{logger: logger.NewNop()}
 
 	originalProtoData := readProtoData(t, c, originalDir)
 
@@ -34,20 +37,6 @@ func TestCore_BreakingCheck(t *testing.T) {
 		"broken": {
 			path: brokenDir,
 			wantIssues: []IssueInfo{
-				{
-					Issue: Issue{
-						Position: meta.Position{
-							Filename: "",
-							Offset:   39,
-							Line:     5,
-							Column:   1,
-						},
-						SourceName: "",
-						Message:    "Previously import \"\"messages.proto\"\" was deleted.\n",
-						RuleName:   breakingCheckRuleName,
-					},
-					Path: "services.proto",
-				},
 				{
 					Issue: Issue{
 						Position: meta.Position{
@@ -58,9 +47,12 @@ func TestCore_BreakingCheck(t *testing.T) {
 						},
 						SourceName: "",
 						Message:    "Previously present field \"1\" with name \"field_1\" on message \"RPC1Request\" was deleted.",
-						RuleName:   breakingCheckRuleName,
+						RuleName:   ruleFieldNoDelete,
+						Severity:   SeverityError,
+						Hint:       "Add field \"field_1\" back and mark it deprecated, or reserve field number 1 and name \"field_1\".",
 					},
-					Path: "messages.proto",
+					Path:  "messages.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC1Request"},
 				},
 				{
 					Issue: Issue{
@@ -72,9 +64,12 @@ func TestCore_BreakingCheck(t *testing.T) {
 						},
 						SourceName: "",
 						Message:    "Previously present enum value \"2\" on enum \"SomeEnum\" was deleted.",
-						RuleName:   breakingCheckRuleName,
+						RuleName:   ruleEnumValueNoDelete,
+						Severity:   SeverityError,
+						Hint:       "Add enum value \"CORPUS_WEB\" back and mark it deprecated, or reserve number 2 and name \"CORPUS_WEB\".",
 					},
-					Path: "services.proto",
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking"},
 				},
 				{
 					Issue: Issue{
@@ -86,9 +81,12 @@ func TestCore_BreakingCheck(t *testing.T) {
 						},
 						SourceName: "",
 						Message:    "Previously present RPC \"RPC2\" on service \"Service\" was deleted.",
-						RuleName:   breakingCheckRuleName,
+						RuleName:   ruleRPCNoDelete,
+						Severity:   SeverityError,
+						Hint:       "Add the RPC \"RPC2\" back and mark it deprecated.",
 					},
-					Path: "services.proto",
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Service: "Service"},
 				},
 				{
 					Issue: Issue{
@@ -100,9 +98,12 @@ func TestCore_BreakingCheck(t *testing.T) {
 						},
 						SourceName: "",
 						Message:    "Previously present field \"2\" with name \"password\" on message \"AuthInfo\" was deleted.",
-						RuleName:   breakingCheckRuleName,
+						RuleName:   ruleFieldNoDelete,
+						Severity:   SeverityError,
+						Hint:       "Add field \"password\" back and mark it deprecated, or reserve field number 2 and name \"password\".",
 					},
-					Path: "services.proto",
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "AuthInfo"},
 				},
 				{
 					Issue: Issue{
@@ -114,9 +115,12 @@ func TestCore_BreakingCheck(t *testing.T) {
 						},
 						SourceName: "",
 						Message:    "Previously present field \"1\" with name \"rpc2_response_nested_field\" on message \"RPC2Response.RPC2ResponseNested\" was deleted.",
-						RuleName:   breakingCheckRuleName,
+						RuleName:   ruleFieldNoDelete,
+						Severity:   SeverityError,
+						Hint:       "Add field \"rpc2_response_nested_field\" back and mark it deprecated, or reserve field number 1 and name \"rpc2_response_nested_field\".",
 					},
-					Path: "services.proto",
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC2Response.RPC2ResponseNested"},
 				},
 				{
 					Issue: Issue{
@@ -128,9 +132,131 @@ func TestCore_BreakingCheck(t *testing.T) {
 						},
 						SourceName: "",
 						Message:    "Previously present field \"4\" with name \"rrr\" on OneOf \"RPC2Response.login\" was deleted.",
-						RuleName:   breakingCheckRuleName,
+						RuleName:   ruleOneOfFieldNoDelete,
+						Severity:   SeverityError,
+						Hint:       "Add field \"rrr\" back and mark it deprecated, or reserve field number 4 and name \"rrr\".",
+					},
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC2Response"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   316,
+							Line:     23,
+							Column:   5,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"1\" with name \"rpc2_response_nested_field\" on message \"RPC2Response.RPC2ResponseNested\" was deleted without reserving the number.",
+						RuleName:   ruleFieldNoDeleteUnlessNumberReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field number 1 on message \"RPC2Response.RPC2ResponseNested\".",
+					},
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC2Response.RPC2ResponseNested"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   316,
+							Line:     23,
+							Column:   5,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"1\" with name \"rpc2_response_nested_field\" on message \"RPC2Response.RPC2ResponseNested\" was deleted without reserving the name.",
+						RuleName:   ruleFieldNoDeleteUnlessNameReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field name \"rpc2_response_nested_field\" on message \"RPC2Response.RPC2ResponseNested\".",
+					},
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC2Response.RPC2ResponseNested"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   542,
+							Line:     37,
+							Column:   3,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"2\" with name \"password\" on message \"AuthInfo\" was deleted without reserving the number.",
+						RuleName:   ruleFieldNoDeleteUnlessNumberReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field number 2 on message \"AuthInfo\".",
+					},
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "AuthInfo"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   542,
+							Line:     37,
+							Column:   3,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"2\" with name \"password\" on message \"AuthInfo\" was deleted without reserving the name.",
+						RuleName:   ruleFieldNoDeleteUnlessNameReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field name \"password\" on message \"AuthInfo\".",
+					},
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "AuthInfo"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   63,
+							Line:     6,
+							Column:   3,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"1\" with name \"field_1\" on message \"RPC1Request\" was deleted without reserving the number.",
+						RuleName:   ruleFieldNoDeleteUnlessNumberReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field number 1 on message \"RPC1Request\".",
+					},
+					Path:  "messages.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC1Request"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   463,
+							Line:     31,
+							Column:   5,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"4\" with name \"rrr\" on message \"RPC2Response\" was deleted without reserving the number.",
+						RuleName:   ruleFieldNoDeleteUnlessNumberReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field number 4 on message \"RPC2Response\".",
+					},
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC2Response"},
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{
+							Filename: "",
+							Offset:   463,
+							Line:     31,
+							Column:   5,
+						},
+						SourceName: "",
+						Message:    "Previously present field \"4\" with name \"rrr\" on message \"RPC2Response\" was deleted without reserving the name.",
+						RuleName:   ruleFieldNoDeleteUnlessNameReserved,
+						Severity:   SeverityError,
+						Hint:       "Reserve field name \"rrr\" on message \"RPC2Response\".",
 					},
-					Path: "services.proto",
+					Path:  "services.proto",
+					Scope: IssueScope{Package: "breaking", Message: "RPC2Response"},
 				},
 			},
 		},
@@ -169,3 +295,197 @@ func readProtoData(t *testing.T, c *Core, path string) ProtoData {
 	require.NoError(t, err)
 	return protoData
 }
+
+func TestCore_BreakingCheck_Changes(t *testing.T) {
+	t.Parallel()
+
+	const (
+		originalChangesDir = "../../testdata/breaking_check/changes/original"
+		currentChangesDir  = "../../testdata/breaking_check/changes/current"
+	)
+
+	c := &Core{logger: logger.NewNop()}
+
+	breakingChecker := &BreakingChecker{
+		against: readProtoData(t, c, originalChangesDir),
+		current: readProtoData(t, c, currentChangesDir),
+	}
+
+	issues, err := breakingChecker.Check()
+	require.NoError(t, err)
+
+	got := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		got = append(got, fmt.Sprintf("%s:%d %s %s", issue.Path, issue.Position.Line, issue.RuleName, issue.Message))
+	}
+
+	want := []string{
+		`changes.proto:5 FIELD_NO_DELETE Previously present field "14" with name "moved" on message "Request" was deleted.`,
+		`changes.proto:5 FIELD_NO_DELETE Previously present field "8" with name "deleted_reserved" on message "Request" was deleted.`,
+		`changes.proto:5 FIELD_NO_DELETE Previously present field "9" with name "deleted" on message "Request" was deleted.`,
+		`changes.proto:9 FIELD_SAME_LABEL Field "1" with name "tags" on message "Request" changed label from "repeated" to "singular".`,
+		`changes.proto:10 FIELD_SAME_LABEL Field "2" with name "single" on message "Request" changed label from "singular" to "repeated".`,
+		`changes.proto:11 FIELD_SAME_MAP_KEY_TYPE Map field "3" with name "counters" on message "Request" changed key type from "string" to "int64".`,
+		`changes.proto:12 FIELD_SAME_MAP_VALUE_TYPE Map field "4" with name "labels" on message "Request" changed value type from "string" to "int64".`,
+		`changes.proto:13 FIELD_SAME_NAME Field "5" on message "Request" changed name from "user_name" to "login", so its JSON name changed from "userName" to "login".`,
+		`changes.proto:14 FIELD_SAME_JSON_NAME Field "6" with name "display_name" on message "Request" changed JSON name from "displayName" to "name".`,
+		`changes.proto:16 RESERVED_NUMBER_NO_REUSE Field "11" with name "reused" on message "Request" uses previously reserved number.`,
+		`changes.proto:17 FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED Previously present field "9" with name "deleted" on message "Request" was deleted without reserving the number.`,
+		`changes.proto:17 FIELD_NO_DELETE_UNLESS_NAME_RESERVED Previously present field "9" with name "deleted" on message "Request" was deleted without reserving the name.`,
+		`changes.proto:17 RESERVED_NAME_NO_REUSE Field "17" with name "old_name" on message "Request" uses previously reserved name.`,
+		`changes.proto:18 FIELD_SAME_LABEL Field "13" with name "attributes" on message "Request" changed label from "map" to "repeated".`,
+		`changes.proto:23 ONEOF_FIELD_NO_DELETE Previously present field "16" with name "b" on OneOf "Request.kind" was deleted.`,
+		`changes.proto:23 FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED Previously present field "16" with name "b" on message "Request" was deleted without reserving the number.`,
+		`changes.proto:30 RPC_SAME_CLIENT_STREAMING RPC "Unary" on service "Service" changed client streaming from "false" to "true".`,
+		`changes.proto:31 RPC_SAME_CLIENT_STREAMING RPC "ClientStream" on service "Service" changed client streaming from "true" to "false".`,
+		`changes.proto:32 RPC_SAME_SERVER_STREAMING RPC "ServerStream" on service "Service" changed server streaming from "true" to "false".`,
+	}
+
+	require.ElementsMatch(t, want, got)
+
+	hints := make(map[string]string)
+	for _, issue := range issues {
+		hints[issue.RuleName] = issue.Hint
+	}
+	require.Equal(t,
+		`Rename field "old_name" to a name which is not reserved, or remove "old_name" from reserved names.`,
+		hints["RESERVED_NAME_NO_REUSE"],
+	)
+}
+
+func TestCore_BreakingCheck_Files(t *testing.T) {
+	t.Parallel()
+
+	const (
+		originalFilesDir = "../../testdata/breaking_check/files/original"
+		currentFilesDir  = "../../testdata/breaking_check/files/current"
+	)
+
+	c := &Core{logger: logger.NewNop()}
+
+	breakingChecker := &BreakingChecker{
+		against: readProtoData(t, c, originalFilesDir),
+		current: readProtoData(t, c, currentFilesDir),
+	}
+
+	issues, err := breakingChecker.Check()
+	require.NoError(t, err)
+
+	got := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		got = append(got, fmt.Sprintf("%s:%d %s %s", issue.Path, issue.Position.Line, issue.RuleName, issue.Message))
+	}
+
+	want := []string{
+		`a.proto:6 IMPORT_NO_DELETE Previously import ""other/other.proto"" was deleted.` + "\n",
+		`a.proto:8 FILE_SAME_GO_PACKAGE File "a.proto" changed option "go_package" from "example.com/files;files" to "example.com/files/v2;files".`,
+		`a.proto:11 FIELD_NO_DELETE Previously present field "2" with name "other" on message "A" was deleted.`,
+		`a.proto:14 FIELD_SAME_TYPE Field "3" with name "nested" on message "A" changed type from "files.A.Nested" to "files.Nested".`,
+		`a.proto:17 MESSAGE_NO_DELETE Previously present message "A.Nested" was deleted from file.` + "\n",
+		`renamed.proto:3 FILE_NO_DELETE Previously present file "renamed.proto" was deleted.`,
+		`repackaged.proto:3 FILE_SAME_PACKAGE File "repackaged.proto" changed package from "files" to "files.v2".`,
+		`repackaged.proto:5 MESSAGE_NO_DELETE Previously present message "Repackaged" was deleted from file.` + "\n",
+	}
+
+	require.ElementsMatch(t, want, got)
+}
+
+func TestCore_BreakingCheck_Types(t *testing.T) {
+	t.Parallel()
+
+	const (
+		originalTypesDir = "../../testdata/breaking_check/types/original"
+		currentTypesDir  = "../../testdata/breaking_check/types/current"
+	)
+
+	c := &Core{logger: logger.NewNop()}
+
+	breakingChecker := &BreakingChecker{
+		against: readProtoData(t, c, originalTypesDir),
+		current: readProtoData(t, c, currentTypesDir),
+	}
+
+	issues, err := breakingChecker.Check()
+	require.NoError(t, err)
+
+	got := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		got = append(got, fmt.Sprintf("%s:%d %s %s", issue.Path, issue.Position.Line, issue.RuleName, issue.Message))
+	}
+
+	// types written differently but resolved to the same descriptors are not reported
+	want := []string{
+		`types.proto:12 FIELD_SAME_TYPE Field "3" with name "qualified" on message "Outer" changed type from "types.Outer.Inner" to "types.Inner".`,
+		`types.proto:18 RPC_SAME_RESPONSE_TYPE RPC "Call" on service "Service" changed response type from "types.Inner" to "types.Outer.Inner".`,
+	}
+
+	require.ElementsMatch(t, want, got)
+}
+
+func TestFieldJSONName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name    string
+		options []*parser.FieldOption
+		want    string
+	}{
+		"snake_case": {
+			name: "user_name",
+			want: "userName",
+		},
+		"digits_and_upper": {
+			name: "field_1_ID",
+			want: "field1ID",
+		},
+		"json_name_option": {
+			name:    "user_name",
+			options: []*parser.FieldOption{{OptionName: "json_name", Constant: `"login"`}},
+			want:    "login",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, fieldJSONName(tc.name, tc.options))
+		})
+	}
+}
+
+func TestResolveType(t *testing.T) {
+	t.Parallel()
+
+	types := map[string]struct{}{
+		"pkg.Foo":         {},
+		"pkg.Foo.Bar":     {},
+		"pkg.Bar":         {},
+		"other.Baz":       {},
+		"pkg.sub.Qux":     {},
+		"NoPackageString": {},
+	}
+
+	tests := map[string]struct {
+		scope, typeName string
+		want            string
+	}{
+		"nested":           {scope: "pkg.Foo", typeName: "Bar", want: "pkg.Foo.Bar"},
+		"package":          {scope: "pkg.Baz", typeName: "Bar", want: "pkg.Bar"},
+		"qualified":        {scope: "pkg.Foo", typeName: "pkg.Bar", want: "pkg.Bar"},
+		"fully_qualified":  {scope: "pkg.Foo", typeName: ".pkg.Bar", want: "pkg.Bar"},
+		"other_package":    {scope: "pkg.Foo", typeName: "other.Baz", want: "other.Baz"},
+		"relative_package": {scope: "pkg.Foo", typeName: "sub.Qux", want: "pkg.sub.Qux"},
+		"without_package":  {scope: "", typeName: "NoPackageString", want: "NoPackageString"},
+		"scalar":           {scope: "pkg.Foo", typeName: "string", want: "string"},
+		"unknown_type":     {scope: "pkg.Foo", typeName: "Unknown", want: "Unknown"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, resolveType(types, tc.scope, tc.typeName))
+		})
+	}
+}
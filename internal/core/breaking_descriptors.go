@@ -0,0 +1,150 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// attachDescriptors sets compiled descriptors to the collected services and messages of the file and its imports.
+func attachDescriptors(protoData ProtoData, fd protoreflect.FileDescriptor, attached map[string]struct{}) {
+	if _, ok := attached[fd.Path()]; ok {
+		return
+	}
+	attached[fd.Path()] = struct{}{}
+
+	imports := fd.Imports()
+	for i := range imports.Len() {
+		if imp := imports.Get(i); !imp.IsPlaceholder() {
+			attachDescriptors(protoData, imp.FileDescriptor, attached)
+		}
+	}
+
+	collection, ok := protoData[PackageName(fd.Package())]
+	if !ok {
+		return
+	}
+
+	services := fd.Services()
+	for i := range services.Len() {
+		sd := services.Get(i)
+
+		service, ok := collection.Services[string(sd.Name())]
+		if !ok || service.ProtoFilePath != fd.Path() || service.Descriptor != nil {
+			continue
+		}
+
+		service.Descriptor = sd
+		collection.Services[string(sd.Name())] = service
+	}
+
+	attachMessageDescriptors(collection, fd, fd.Messages())
+}
+
+func attachMessageDescriptors(
+	collection *Collection, fd protoreflect.FileDescriptor, messages protoreflect.MessageDescriptors,
+) {
+	for i := range messages.Len() {
+		md := messages.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+
+		messagePath := strings.TrimPrefix(string(md.FullName()), string(fd.Package())+".")
+
+		message, ok := collection.Messages[messagePath]
+		if ok && message.ProtoFilePath == fd.Path() && message.Descriptor == nil {
+			message.Descriptor = md
+			collection.Messages[messagePath] = message
+		}
+
+		attachMessageDescriptors(collection, fd, md.Messages())
+	}
+}
+
+// fieldType returns fully-qualified name of message or enum type of the field with the number or its scalar type,
+// value type is returned for map fields. Type is taken from the compiled message if it is available,
+// otherwise typeName is resolved by name, see resolveType.
+func fieldType(types map[string]struct{}, message Message, number, typeName string) string {
+	fd := fieldDescriptor(message, number)
+	if fd == nil {
+		return resolveType(types, getProtoEntityPath(string(message.PackageName), message.MessagePath), typeName)
+	}
+
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// rpcTypes returns fully-qualified names of request and response types of the RPC,
+// they are taken from the compiled service if it is available, otherwise they are resolved by names.
+func rpcTypes(types map[string]struct{}, service Service, rpc *parser.RPC) (string, string) {
+	if md := methodDescriptor(service, rpc.RPCName); md != nil {
+		return string(md.Input().FullName()), string(md.Output().FullName())
+	}
+
+	scope := string(service.PackageName)
+
+	return resolveType(types, scope, rpc.RPCRequest.MessageType), resolveType(types, scope, rpc.RPCResponse.MessageType)
+}
+
+// fieldPosition returns position of the field from source info of the compiled message or pos if it is not compiled.
+func fieldPosition(message Message, number string, pos meta.Position) meta.Position {
+	fd := fieldDescriptor(message, number)
+	if fd == nil {
+		return pos
+	}
+
+	return descriptorPositionOr(fd, pos)
+}
+
+// rpcPosition returns position of the RPC from source info of the compiled service or pos if it is not compiled.
+func rpcPosition(service Service, name string, pos meta.Position) meta.Position {
+	md := methodDescriptor(service, name)
+	if md == nil {
+		return pos
+	}
+
+	return descriptorPositionOr(md, pos)
+}
+
+func descriptorPositionOr(d protoreflect.Descriptor, pos meta.Position) meta.Position {
+	res := DescriptorPosition(d)
+	if res.Line == 0 {
+		return pos
+	}
+
+	return res
+}
+
+func fieldDescriptor(message Message, number string) protoreflect.FieldDescriptor {
+	if message.Descriptor == nil {
+		return nil
+	}
+
+	n, ok := parseFieldNumber(number)
+	if !ok {
+		return nil
+	}
+
+	return message.Descriptor.Fields().ByNumber(protoreflect.FieldNumber(n))
+}
+
+func methodDescriptor(service Service, name string) protoreflect.MethodDescriptor {
+	if service.Descriptor == nil {
+		return nil
+	}
+
+	return service.Descriptor.Methods().ByName(protoreflect.Name(name))
+}
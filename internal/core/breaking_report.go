@@ -0,0 +1,107 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+)
+
+type (
+	// BreakingReport summarizes breaking issues grouped by package, service and message.
+	BreakingReport struct {
+		Total int `json:"total"`
+		// Categories contains number of issues breaking compatibility of every category.
+		Categories map[string]int        `json:"categories"`
+		Groups     []BreakingReportGroup `json:"groups"`
+	}
+
+	// BreakingReportGroup contains issues of one package, service or message.
+	BreakingReportGroup struct {
+		IssueScope
+		Total      int            `json:"total"`
+		Categories map[string]int `json:"categories"`
+		Issues     []IssueInfo    `json:"issues"`
+	}
+)
+
+// NewBreakingReport groups issues by their scopes, groups are sorted by package, service and message,
+// issues of every group are sorted by path and position.
+func NewBreakingReport(issues []IssueInfo) BreakingReport {
+	ruleCategories := make(map[string][]string)
+	for _, rule := range BreakingRules() {
+		ruleCategories[rule.Name] = rule.Categories
+	}
+
+	report := BreakingReport{
+		Categories: newCategoryCounts(),
+		Groups:     []BreakingReportGroup{},
+	}
+	groups := make(map[IssueScope]*BreakingReportGroup)
+
+	for _, issue := range issues {
+		group, ok := groups[issue.Scope]
+		if !ok {
+			group = &BreakingReportGroup{
+				IssueScope: issue.Scope,
+				Categories: newCategoryCounts(),
+			}
+			groups[issue.Scope] = group
+		}
+
+		group.Total++
+		group.Issues = append(group.Issues, issue)
+		report.Total++
+
+		for _, category := range ruleCategories[issue.RuleName] {
+			group.Categories[category]++
+			report.Categories[category]++
+		}
+	}
+
+	for _, group := range groups {
+		slices.SortStableFunc(group.Issues, func(a, b IssueInfo) int {
+			return cmp.Or(
+				cmp.Compare(a.Path, b.Path),
+				cmp.Compare(a.Position.Line, b.Position.Line),
+				cmp.Compare(a.Position.Column, b.Position.Column),
+			)
+		})
+
+		report.Groups = append(report.Groups, *group)
+	}
+
+	slices.SortFunc(report.Groups, func(a, b BreakingReportGroup) int {
+		return cmp.Or(
+			cmp.Compare(a.Package, b.Package),
+			cmp.Compare(a.Service, b.Service),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+
+	return report
+}
+
+// Name returns fully-qualified name of the service or message of the group or name of its package.
+func (g BreakingReportGroup) Name() string {
+	name := g.Service
+	if name == "" {
+		name = g.Message
+	}
+
+	switch {
+	case g.Package == "":
+		return name
+	case name == "":
+		return g.Package
+	default:
+		return g.Package + "." + name
+	}
+}
+
+func newCategoryCounts() map[string]int {
+	res := make(map[string]int)
+	for _, category := range BreakingCategories() {
+		res[category] = 0
+	}
+
+	return res
+}
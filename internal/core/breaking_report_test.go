@@ -0,0 +1,80 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+func TestNewBreakingReport(t *testing.T) {
+	t.Parallel()
+
+	issue := func(ruleName, path string, line int, scope IssueScope) IssueInfo {
+		return IssueInfo{
+			Issue: Issue{Position: meta.Position{Line: line}, RuleName: ruleName},
+			Path:  path,
+			Scope: scope,
+		}
+	}
+
+	service := IssueScope{Package: "acme.v1", Service: "UserService"}
+	message := IssueScope{Package: "acme.v1", Message: "User"}
+	pkg := IssueScope{Package: "acme.v1"}
+
+	rpcDeleted := issue(ruleRPCNoDelete, "service.proto", 10, service)
+	fieldRenamed := issue(ruleFieldSameName, "user.proto", 7, message)
+	fieldTypeChanged := issue(ruleFieldSameType, "user.proto", 5, message)
+	importDeleted := issue(ruleImportNoDelete, "user.proto", 3, pkg)
+
+	got := NewBreakingReport([]IssueInfo{rpcDeleted, fieldRenamed, fieldTypeChanged, importDeleted})
+
+	require.Equal(t, BreakingReport{
+		Total: 4,
+		Categories: map[string]int{
+			BreakingCategoryFile:     4,
+			BreakingCategoryPackage:  3,
+			BreakingCategoryWireJSON: 2,
+			BreakingCategoryWire:     1,
+		},
+		Groups: []BreakingReportGroup{
+			{
+				IssueScope: pkg,
+				Total:      1,
+				Categories: map[string]int{
+					BreakingCategoryFile:     1,
+					BreakingCategoryPackage:  0,
+					BreakingCategoryWireJSON: 0,
+					BreakingCategoryWire:     0,
+				},
+				Issues: []IssueInfo{importDeleted},
+			},
+			{
+				IssueScope: message,
+				Total:      2,
+				Categories: map[string]int{
+					BreakingCategoryFile:     2,
+					BreakingCategoryPackage:  2,
+					BreakingCategoryWireJSON: 2,
+					BreakingCategoryWire:     1,
+				},
+				Issues: []IssueInfo{fieldTypeChanged, fieldRenamed},
+			},
+			{
+				IssueScope: service,
+				Total:      1,
+				Categories: map[string]int{
+					BreakingCategoryFile:     1,
+					BreakingCategoryPackage:  1,
+					BreakingCategoryWireJSON: 0,
+					BreakingCategoryWire:     0,
+				},
+				Issues: []IssueInfo{rpcDeleted},
+			},
+		},
+	}, got)
+
+	require.Equal(t, []string{"acme.v1", "acme.v1.User", "acme.v1.UserService"},
+		[]string{got.Groups[0].Name(), got.Groups[1].Name(), got.Groups[2].Name()},
+	)
+}
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Categories of breaking rules, every next category is less strict:
+//   - FILE checks that generated code of every file stays compatible;
+//   - PACKAGE checks that generated code of every package stays compatible, so elements can be moved between files;
+//   - WIRE_JSON checks that binary and JSON encodings stay compatible;
+//   - WIRE checks that binary encoding stays compatible.
+const (
+	BreakingCategoryFile     = "FILE"
+	BreakingCategoryPackage  = "PACKAGE"
+	BreakingCategoryWireJSON = "WIRE_JSON"
+	BreakingCategoryWire     = "WIRE"
+)
+
+// BreakingCategories returns categories of breaking rules from the most strict to the least strict.
+func BreakingCategories() []string {
+	return []string{
+		BreakingCategoryFile, BreakingCategoryPackage, BreakingCategoryWireJSON, BreakingCategoryWire,
+	}
+}
+
+// Names of breaking rules.
+const (
+	ruleFileNoDelete                      = "FILE_NO_DELETE"
+	ruleFileSamePackage                   = "FILE_SAME_PACKAGE"
+	ruleFileSameGoPackage                 = "FILE_SAME_GO_PACKAGE"
+	ruleFileSameJavaPackage               = "FILE_SAME_JAVA_PACKAGE"
+	ruleImportNoDelete                    = "IMPORT_NO_DELETE"
+	ruleServiceNoDelete                   = "SERVICE_NO_DELETE"
+	ruleRPCNoDelete                       = "RPC_NO_DELETE"
+	ruleRPCSameRequestType                = "RPC_SAME_REQUEST_TYPE"
+	ruleRPCSameResponseType               = "RPC_SAME_RESPONSE_TYPE"
+	ruleRPCSameClientStreaming            = "RPC_SAME_CLIENT_STREAMING"
+	ruleRPCSameServerStreaming            = "RPC_SAME_SERVER_STREAMING"
+	ruleMessageNoDelete                   = "MESSAGE_NO_DELETE"
+	ruleFieldNoDelete                     = "FIELD_NO_DELETE"
+	ruleFieldNoDeleteUnlessNumberReserved = "FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED"
+	ruleFieldNoDeleteUnlessNameReserved   = "FIELD_NO_DELETE_UNLESS_NAME_RESERVED"
+	ruleFieldSameType                     = "FIELD_SAME_TYPE"
+	ruleFieldSameCardinality              = "FIELD_SAME_CARDINALITY"
+	ruleFieldSameLabel                    = "FIELD_SAME_LABEL"
+	ruleFieldSameMapKeyType               = "FIELD_SAME_MAP_KEY_TYPE"
+	ruleFieldSameMapValueType             = "FIELD_SAME_MAP_VALUE_TYPE"
+	ruleFieldSameJSONName                 = "FIELD_SAME_JSON_NAME"
+	ruleFieldSameName                     = "FIELD_SAME_NAME"
+	ruleReservedNumberNoReuse             = "RESERVED_NUMBER_NO_REUSE"
+	ruleReservedNameNoReuse               = "RESERVED_NAME_NO_REUSE"
+	ruleOneOfNoDelete                     = "ONEOF_NO_DELETE"
+	ruleOneOfFieldNoDelete                = "ONEOF_FIELD_NO_DELETE"
+	ruleOneOfFieldSameType                = "ONEOF_FIELD_SAME_TYPE"
+	ruleEnumNoDelete                      = "ENUM_NO_DELETE"
+	ruleEnumValueNoDelete                 = "ENUM_VALUE_NO_DELETE"
+	ruleEnumValueSameName                 = "ENUM_VALUE_SAME_NAME"
+)
+
+// BreakingRule is a breaking rule with categories it belongs to.
+type BreakingRule struct {
+	Name       string   `json:"name"`
+	Categories []string `json:"categories"`
+}
+
+var (
+	fileCategories     = []string{BreakingCategoryFile}
+	packageCategories  = []string{BreakingCategoryFile, BreakingCategoryPackage}
+	wireJSONCategories = []string{BreakingCategoryFile, BreakingCategoryPackage, BreakingCategoryWireJSON}
+	wireCategories     = []string{
+		BreakingCategoryFile, BreakingCategoryPackage, BreakingCategoryWireJSON, BreakingCategoryWire,
+	}
+)
+
+// BreakingRules returns all breaking rules.
+func BreakingRules() []BreakingRule {
+	return []BreakingRule{
+		{Name: ruleFileNoDelete, Categories: fileCategories},
+		{Name: ruleFileSamePackage, Categories: fileCategories},
+		{Name: ruleFileSameGoPackage, Categories: fileCategories},
+		{Name: ruleFileSameJavaPackage, Categories: fileCategories},
+		{Name: ruleImportNoDelete, Categories: fileCategories},
+		{Name: ruleServiceNoDelete, Categories: packageCategories},
+		{Name: ruleRPCNoDelete, Categories: packageCategories},
+		{Name: ruleRPCSameRequestType, Categories: wireCategories},
+		{Name: ruleRPCSameResponseType, Categories: wireCategories},
+		{Name: ruleRPCSameClientStreaming, Categories: wireCategories},
+		{Name: ruleRPCSameServerStreaming, Categories: wireCategories},
+		{Name: ruleMessageNoDelete, Categories: packageCategories},
+		{Name: ruleFieldNoDelete, Categories: packageCategories},
+		{Name: ruleFieldNoDeleteUnlessNumberReserved, Categories: wireCategories},
+		{Name: ruleFieldNoDeleteUnlessNameReserved, Categories: wireJSONCategories},
+		{Name: ruleFieldSameType, Categories: wireCategories},
+		{Name: ruleFieldSameCardinality, Categories: packageCategories},
+		{Name: ruleFieldSameLabel, Categories: wireCategories},
+		{Name: ruleFieldSameMapKeyType, Categories: wireCategories},
+		{Name: ruleFieldSameMapValueType, Categories: wireCategories},
+		{Name: ruleFieldSameJSONName, Categories: wireJSONCategories},
+		{Name: ruleFieldSameName, Categories: wireJSONCategories},
+		{Name: ruleReservedNumberNoReuse, Categories: wireCategories},
+		{Name: ruleReservedNameNoReuse, Categories: wireJSONCategories},
+		{Name: ruleOneOfNoDelete, Categories: packageCategories},
+		{Name: ruleOneOfFieldNoDelete, Categories: packageCategories},
+		{Name: ruleOneOfFieldSameType, Categories: wireCategories},
+		{Name: ruleEnumNoDelete, Categories: packageCategories},
+		{Name: ruleEnumValueNoDelete, Categories: packageCategories},
+		{Name: ruleEnumValueSameName, Categories: wireJSONCategories},
+	}
+}
+
+// ResolveBreakingRules returns names of breaking rules enabled by use and not disabled by except.
+// Both lists can contain rule names and categories, FILE category is used if use is empty.
+func ResolveBreakingRules(use, except []string) ([]string, error) {
+	if len(use) == 0 {
+		use = []string{BreakingCategoryFile}
+	}
+
+	enabled, err := unwrapBreakingCategories(use)
+	if err != nil {
+		return nil, fmt.Errorf("use: %w", err)
+	}
+
+	disabled, err := unwrapBreakingCategories(except)
+	if err != nil {
+		return nil, fmt.Errorf("except: %w", err)
+	}
+
+	res := make([]string, 0, len(enabled))
+	for _, rule := range BreakingRules() {
+		if slices.Contains(enabled, rule.Name) && !slices.Contains(disabled, rule.Name) {
+			res = append(res, rule.Name)
+		}
+	}
+
+	return res, nil
+}
+
+// UnwrapBreakingIgnoreOnly returns paths ignored by rule names, categories are replaced by their rules.
+func UnwrapBreakingIgnoreOnly(ignoreOnly map[string][]string) (map[string][]string, error) {
+	res := make(map[string][]string, len(ignoreOnly))
+
+	for ruleOrCategory, paths := range ignoreOnly {
+		ruleNames, err := unwrapBreakingCategories([]string{ruleOrCategory})
+		if err != nil {
+			return nil, fmt.Errorf("ignore_only: %w", err)
+		}
+
+		for _, ruleName := range ruleNames {
+			res[ruleName] = append(res[ruleName], paths...)
+		}
+	}
+
+	return res, nil
+}
+
+// unwrapBreakingCategories replaces categories by their rules and checks that other names are rules.
+func unwrapBreakingCategories(names []string) ([]string, error) {
+	rules := BreakingRules()
+	res := make([]string, 0, len(names))
+
+	for _, name := range names {
+		switch name {
+		case BreakingCategoryFile, BreakingCategoryPackage, BreakingCategoryWireJSON, BreakingCategoryWire:
+			for _, rule := range rules {
+				if slices.Contains(rule.Categories, name) {
+					res = append(res, rule.Name)
+				}
+			}
+			continue
+		}
+
+		if !slices.ContainsFunc(rules, func(rule BreakingRule) bool { return rule.Name == name }) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRule, name)
+		}
+
+		res = append(res, name)
+	}
+
+	return res, nil
+}
+
+// unstablePackageRegexp matches last component of unstable package, e.g. foo.v1alpha1, foo.v1beta, foo.v1test.
+var unstablePackageRegexp = regexp.MustCompile(`^v\d+(?:(?:alpha|beta)\d*|test\w*)$`)
+
+// isUnstablePackage reports whether the package is an alpha, beta or test version.
+func isUnstablePackage(packageName PackageName) bool {
+	parts := strings.Split(string(packageName), ".")
+
+	return unstablePackageRegexp.MatchString(parts[len(parts)-1])
+}
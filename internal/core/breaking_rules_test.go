@@ -0,0 +1,140 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveBreakingRules(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		use, except []string
+		want        []string
+		wantErr     error
+	}{
+		"default_is_file": {
+			want: func() []string {
+				var res []string
+				for _, rule := range BreakingRules() {
+					res = append(res, rule.Name)
+				}
+				return res
+			}(),
+		},
+		"wire": {
+			use: []string{BreakingCategoryWire},
+			want: []string{
+				ruleRPCSameRequestType,
+				ruleRPCSameResponseType,
+				ruleRPCSameClientStreaming,
+				ruleRPCSameServerStreaming,
+				ruleFieldNoDeleteUnlessNumberReserved,
+				ruleFieldSameType,
+				ruleFieldSameLabel,
+				ruleFieldSameMapKeyType,
+				ruleFieldSameMapValueType,
+				ruleReservedNumberNoReuse,
+				ruleOneOfFieldSameType,
+			},
+		},
+		"rules_and_except": {
+			use:    []string{ruleFieldNoDelete, BreakingCategoryWire},
+			except: []string{ruleFieldSameType, ruleFieldSameLabel, ruleFieldSameMapKeyType, ruleFieldSameMapValueType},
+			want: []string{
+				ruleRPCSameRequestType,
+				ruleRPCSameResponseType,
+				ruleRPCSameClientStreaming,
+				ruleRPCSameServerStreaming,
+				ruleFieldNoDelete,
+				ruleFieldNoDeleteUnlessNumberReserved,
+				ruleReservedNumberNoReuse,
+				ruleOneOfFieldSameType,
+			},
+		},
+		"invalid_rule": {
+			use:     []string{"FIELD_NO_RENAME"},
+			wantErr: ErrInvalidRule,
+		},
+		"invalid_except": {
+			except:  []string{"BREAKING_CHECK"},
+			wantErr: ErrInvalidRule,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ResolveBreakingRules(tc.use, tc.except)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestUnwrapBreakingIgnoreOnly(t *testing.T) {
+	t.Parallel()
+
+	got, err := UnwrapBreakingIgnoreOnly(map[string][]string{
+		BreakingCategoryWire: {"proto/a"},
+		ruleFieldSameType:    {"proto/b"},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"proto/a", "proto/b"}, got[ruleFieldSameType])
+	require.Equal(t, []string{"proto/a"}, got[ruleRPCSameRequestType])
+	require.NotContains(t, got, ruleFieldNoDelete)
+
+	_, err = UnwrapBreakingIgnoreOnly(map[string][]string{"UNKNOWN": {"proto"}})
+	require.ErrorIs(t, err, ErrInvalidRule)
+}
+
+func TestIsUnstablePackage(t *testing.T) {
+	t.Parallel()
+
+	tests := map[PackageName]bool{
+		"acme.v1":         false,
+		"acme.v1alpha":    true,
+		"acme.v1alpha1":   true,
+		"acme.v2beta3":    true,
+		"acme.v1test":     true,
+		"acme.v1testing":  true,
+		"acme.alpha":      false,
+		"acme.v1.alpha":   false,
+		"v1beta1":         true,
+		"acme.v1beta1.ab": false,
+	}
+
+	for packageName, want := range tests {
+		t.Run(string(packageName), func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, want, isUnstablePackage(packageName))
+		})
+	}
+}
+
+func TestCore_filterBreakingIssues(t *testing.T) {
+	t.Parallel()
+
+	issues := []IssueInfo{
+		{Issue: Issue{RuleName: ruleFieldNoDelete}, Path: "proto/a.proto"},
+		{Issue: Issue{RuleName: ruleFieldSameType}, Path: "proto/a.proto"},
+		{Issue: Issue{RuleName: ruleFieldSameType}, Path: "proto/legacy/b.proto"},
+		{Issue: Issue{RuleName: ruleImportNoDelete}, Path: "proto/a.proto"},
+	}
+
+	c := &Core{
+		breakingCheckConfig: BreakingCheckConfig{
+			Rules:      []string{ruleFieldSameType, ruleImportNoDelete},
+			IgnoreOnly: map[string][]string{ruleFieldSameType: {"proto/legacy/**"}},
+		},
+	}
+
+	got := c.filterBreakingIssues(issues)
+	require.Equal(t, []IssueInfo{
+		{Issue: Issue{RuleName: ruleFieldSameType}, Path: "proto/a.proto"},
+		{Issue: Issue{RuleName: ruleImportNoDelete}, Path: "proto/a.proto"},
+	}, got)
+}
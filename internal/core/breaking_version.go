@@ -0,0 +1,199 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// Version bumps.
+const (
+	VersionBumpMajor = "major"
+	VersionBumpMinor = "minor"
+	VersionBumpPatch = "patch"
+)
+
+// initialVersion is used as the current version if the repository has no version tags.
+const initialVersion = "v0.0.0"
+
+// VersionSuggestion is the next semantic version suggested by breaking changes and additions.
+type VersionSuggestion struct {
+	// Current is the last version tag or v0.0.0 if there are no version tags.
+	Current string `json:"current"`
+	Next    string `json:"next"`
+	Bump    string `json:"bump"`
+	// Breaking is number of breaking issues except ones of FILE category only,
+	// e.g. elements moved between files of the same package.
+	Breaking  int `json:"breaking"`
+	Additions int `json:"additions"`
+	// Packages maps versioned packages with breaking changes to new packages
+	// which can be added instead of the major version, e.g. acme.v1 to acme.v2.
+	Packages map[string]string `json:"packages,omitempty"`
+}
+
+// SuggestVersion compares current proto files with the last version tag (`git describe`) and suggests the next version:
+//   - major if there are breaking changes of the package (all categories except FILE);
+//   - minor if elements were added;
+//   - patch otherwise.
+//
+// Against module or input is used instead of the tag if it is set,
+// configured git ref is used if the repository has no version tags.
+func (c *Core) SuggestVersion(ctx context.Context, workingDir, path string) (VersionSuggestion, error) {
+	current, gitRef := initialVersion, c.breakingCheckConfig.AgainstGitRef
+
+	tag, err := c.currentProjectGitWalker.LastVersionTag(workingDir)
+	switch {
+	case errors.Is(err, ErrNoVersionTag):
+		c.logger.Warn(ctx, "no version tags found", slog.String("current", current), slog.String("against", gitRef))
+	case err != nil:
+		return VersionSuggestion{}, fmt.Errorf("c.currentProjectGitWalker.LastVersionTag: %w", err)
+	default:
+		current, gitRef = tag, tag
+	}
+
+	breakingChecker, err := c.newBreakingChecker(ctx, workingDir, gitRef, path)
+	if err != nil {
+		return VersionSuggestion{}, fmt.Errorf("c.newBreakingChecker: %w", err)
+	}
+
+	issues, err := breakingChecker.Check()
+	if err != nil {
+		return VersionSuggestion{}, fmt.Errorf("breakingChecker.Check: %w", err)
+	}
+
+	res, err := suggestVersion(current, c.filterBreakingIssues(issues), breakingChecker.Additions())
+	if err != nil {
+		return VersionSuggestion{}, fmt.Errorf("suggestVersion: %w", err)
+	}
+
+	return res, nil
+}
+
+func suggestVersion(current string, issues []IssueInfo, additions []Addition) (VersionSuggestion, error) {
+	// rules of FILE category only don't break generated code and clients of the package
+	packageRules := make(map[string]bool)
+	for _, rule := range BreakingRules() {
+		packageRules[rule.Name] = slices.Contains(rule.Categories, BreakingCategoryPackage)
+	}
+
+	res := VersionSuggestion{
+		Current:   current,
+		Additions: len(additions),
+		Packages:  make(map[string]string),
+	}
+
+	for _, issue := range issues {
+		if !packageRules[issue.RuleName] {
+			continue
+		}
+
+		res.Breaking++
+
+		if next, ok := nextPackageVersion(issue.Scope.Package); ok {
+			res.Packages[issue.Scope.Package] = next
+		}
+	}
+
+	switch {
+	case res.Breaking != 0:
+		res.Bump = VersionBumpMajor
+	case res.Additions != 0:
+		res.Bump = VersionBumpMinor
+	default:
+		res.Bump = VersionBumpPatch
+	}
+
+	next, err := bumpVersion(current, res.Bump)
+	if err != nil {
+		return VersionSuggestion{}, fmt.Errorf("bumpVersion: %w", err)
+	}
+	res.Next = next
+
+	return res, nil
+}
+
+// CanonicalVersion adds "v" prefix to the version if it is missing, so it can be used with semver package.
+func CanonicalVersion(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+
+	return "v" + version
+}
+
+// bumpVersion returns the next version, prerelease and build metadata are dropped,
+// "v" prefix is kept as is in the current version.
+// Prerelease is bumped to its release if it's enough for the bump, e.g. v1.4.2-rc.1 to v1.4.2 by patch
+// and v2.0.0-rc.1 to v2.0.0 by major, like in semver prerelease precedes its release.
+func bumpVersion(current, bump string) (string, error) {
+	canonical := semver.Canonical(CanonicalVersion(current))
+	if canonical == "" {
+		return "", fmt.Errorf("invalid semantic version: %s", current)
+	}
+
+	prerelease := semver.Prerelease(canonical)
+	canonical = strings.TrimSuffix(canonical, prerelease)
+
+	parts := strings.Split(strings.TrimPrefix(canonical, "v"), ".")
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return "", fmt.Errorf("strconv.Atoi: %w", err)
+		}
+		numbers[i] = number
+	}
+
+	major, minor, patch := numbers[0], numbers[1], numbers[2]
+	switch bump {
+	case VersionBumpMajor:
+		if prerelease == "" || minor != 0 || patch != 0 {
+			major, minor, patch = major+1, 0, 0
+		}
+	case VersionBumpMinor:
+		if prerelease == "" || patch != 0 {
+			minor, patch = minor+1, 0
+		}
+	default:
+		if prerelease == "" {
+			patch++
+		}
+	}
+
+	prefix := ""
+	if strings.HasPrefix(current, "v") {
+		prefix = "v"
+	}
+
+	return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), nil
+}
+
+// packageVersionRegexp matches last component of stable versioned package, e.g. foo.v1.
+var packageVersionRegexp = regexp.MustCompile(`^v(\d+)$`)
+
+// nextPackageVersion returns package with the next major version, e.g. acme.v2 for acme.v1.
+func nextPackageVersion(packageName string) (string, bool) {
+	i := strings.LastIndex(packageName, ".")
+	if i == -1 {
+		return "", false
+	}
+
+	match := packageVersionRegexp.FindStringSubmatch(packageName[i+1:])
+	if match == nil {
+		return "", false
+	}
+
+	version, err := strconv.Atoi(match[1])
+	if err != nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s.v%d", packageName[:i], version+1), true
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestVersion(t *testing.T) {
+	t.Parallel()
+
+	wireIssue := IssueInfo{
+		Issue: Issue{RuleName: ruleFieldSameType},
+		Scope: IssueScope{Package: "acme.v1", Message: "User"},
+	}
+	unstableWireIssue := IssueInfo{
+		Issue: Issue{RuleName: ruleFieldSameJSONName},
+		Scope: IssueScope{Package: "acme.v1beta1", Message: "User"},
+	}
+	packageIssue := IssueInfo{
+		Issue: Issue{RuleName: ruleMessageNoDelete},
+		Scope: IssueScope{Package: "acme.v1"},
+	}
+	fileIssue := IssueInfo{
+		Issue: Issue{RuleName: ruleFileNoDelete},
+		Scope: IssueScope{Package: "acme.v1"},
+	}
+	addition := Addition{Kind: AdditionKindField, Name: "acme.v1.User.email"}
+
+	tests := map[string]struct {
+		current   string
+		issues    []IssueInfo
+		additions []Addition
+		want      VersionSuggestion
+	}{
+		"major": {
+			current:   "v1.4.2",
+			issues:    []IssueInfo{wireIssue, unstableWireIssue, fileIssue},
+			additions: []Addition{addition},
+			want: VersionSuggestion{
+				Current:   "v1.4.2",
+				Next:      "v2.0.0",
+				Bump:      VersionBumpMajor,
+				Breaking:  2,
+				Additions: 1,
+				Packages:  map[string]string{"acme.v1": "acme.v2"},
+			},
+		},
+		"major by package issue": {
+			current: "v1.4.2",
+			issues:  []IssueInfo{packageIssue},
+			want: VersionSuggestion{
+				Current:  "v1.4.2",
+				Next:     "v2.0.0",
+				Bump:     VersionBumpMajor,
+				Breaking: 1,
+				Packages: map[string]string{"acme.v1": "acme.v2"},
+			},
+		},
+		"minor": {
+			current:   "1.4.2",
+			issues:    []IssueInfo{fileIssue},
+			additions: []Addition{addition},
+			want: VersionSuggestion{
+				Current:   "1.4.2",
+				Next:      "1.5.0",
+				Bump:      VersionBumpMinor,
+				Additions: 1,
+				Packages:  map[string]string{},
+			},
+		},
+		"patch of prerelease": {
+			current: "v1.4.2-rc.1+build.5",
+			want: VersionSuggestion{
+				Current:  "v1.4.2-rc.1+build.5",
+				Next:     "v1.4.2",
+				Bump:     VersionBumpPatch,
+				Packages: map[string]string{},
+			},
+		},
+		"minor of prerelease": {
+			current:   "v1.4.2-rc.1",
+			additions: []Addition{addition},
+			want: VersionSuggestion{
+				Current:   "v1.4.2-rc.1",
+				Next:      "v1.5.0",
+				Bump:      VersionBumpMinor,
+				Additions: 1,
+				Packages:  map[string]string{},
+			},
+		},
+		"major of major prerelease": {
+			current: "v2.0.0-rc.1",
+			issues:  []IssueInfo{wireIssue},
+			want: VersionSuggestion{
+				Current:  "v2.0.0-rc.1",
+				Next:     "v2.0.0",
+				Bump:     VersionBumpMajor,
+				Breaking: 1,
+				Packages: map[string]string{"acme.v1": "acme.v2"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := suggestVersion(tc.current, tc.issues, tc.additions)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSuggestVersion_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := suggestVersion("release-1", nil, nil)
+	require.Error(t, err)
+}
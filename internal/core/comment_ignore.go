@@ -0,0 +1,393 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+// CommentIgnoresConfig configures ignoring of rules by directives in comments:
+//
+//	// nolint:RULE1,RULE2 -- justification
+//	// buf:lint:ignore RULE
+//	// easyp:lint:ignore-file RULE -- justification
+type CommentIgnoresConfig struct {
+	// Allow enables directives, comments are not checked otherwise.
+	Allow bool
+	// RequireJustification disables directives without justification after "--".
+	RequireJustification bool
+	// Groups contains rule names by group key, so groups can be used in directives instead of rules.
+	Groups map[string][]string
+}
+
+const (
+	// for backward compatibility with buf
+	bufLintIgnorePrefix  = "buf:lint:ignore "
+	lintIgnorePrefix     = "nolint:"
+	lintIgnoreFilePrefix = "easyp:lint:ignore-file "
+)
+
+var ignoreDirectiveRegexp = regexp.MustCompile(
+	`(` + regexp.QuoteMeta(bufLintIgnorePrefix) + `|` + regexp.QuoteMeta(lintIgnorePrefix) + `|` + regexp.QuoteMeta(lintIgnoreFilePrefix) + `)` +
+		`[ \t]*([A-Z0-9_]+(?:[ \t]*,[ \t]*[A-Z0-9_]+)*)` +
+		`(?:[ \t]+--[ \t]*([^\n]*))?`,
+)
+
+// ignoreDirective is a directive in comment which disables rules for a part of the file.
+type ignoreDirective struct {
+	// text of the directive as it is written.
+	text string
+	// position of the directive.
+	pos meta.Position
+	// rules contains rule names or group keys.
+	rules []string
+	// justification is a text after "--".
+	justification string
+	// fileScope is true for directives which are applied to the whole file.
+	fileScope bool
+	// fromLine and toLine are lines of the element which the directive is applied to.
+	fromLine, toLine int
+}
+
+// ignores reports whether the directive disables the rule on passed line.
+func (d ignoreDirective) ignores(cfg CommentIgnoresConfig, ruleName string, line int) (string, bool) {
+	if !d.fileScope && (line < d.fromLine || line > d.toLine) {
+		return "", false
+	}
+
+	for _, name := range d.rules {
+		if name == ruleName || slices.Contains(cfg.Groups[name], ruleName) {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// readIgnoreDirectives reads ignore directives from comments of the proto file.
+func (c *Core) readIgnoreDirectives(ctx context.Context, disk FS, path string) ([]ignoreDirective, error) {
+	if !c.commentIgnores.Allow {
+		return nil, nil
+	}
+
+	f, err := disk.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("disk.Open: %w", err)
+	}
+	defer c.close(ctx, f, path)
+
+	src, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	return parseIgnoreDirectives(src), nil
+}
+
+// applyIgnoreDirectives removes issues disabled by ignore directives of their files
+// and reports unused directives if IgnoreDirectivesRule is enabled.
+func (c *Core) applyIgnoreDirectives(files []lintedFile, issues []IssueInfo) []IssueInfo {
+	if !c.commentIgnores.Allow {
+		return issues
+	}
+
+	type usage struct {
+		path      string
+		directive int
+		name      string
+	}
+
+	directives := make(map[string][]ignoreDirective, len(files))
+	for _, file := range files {
+		directives[file.protoInfo.Path] = file.directives
+	}
+
+	used := make(map[usage]bool)
+	res := issues[:0]
+
+	for _, issue := range issues {
+		ignored := false
+
+		for i, directive := range directives[issue.Path] {
+			if c.commentIgnores.RequireJustification && directive.justification == "" {
+				continue
+			}
+
+			name, ok := directive.ignores(c.commentIgnores, issue.RuleName, issue.Position.Line)
+			if !ok {
+				continue
+			}
+
+			used[usage{issue.Path, i, name}] = true
+			ignored = true
+		}
+
+		if !ignored {
+			res = append(res, issue)
+		}
+	}
+
+	for _, file := range files {
+		path := file.protoInfo.Path
+
+		for _, rule := range c.rulesFor(path) {
+			if _, ok := rule.(IgnoreDirectivesRule); !ok || c.shouldIgnore(rule, path) {
+				continue
+			}
+
+			for i, directive := range file.directives {
+				if c.commentIgnores.RequireJustification && directive.justification == "" {
+					res = append(res, IssueInfo{
+						Issue: buildIgnoreDirectiveIssue(
+							rule, directive, directive.text, `ignore directive must have a justification after "--"`,
+						),
+						Path: path,
+					})
+					continue
+				}
+
+				for _, name := range directive.rules {
+					if used[usage{path, i, name}] || !c.isRuleEnabled(name, path) {
+						continue
+					}
+
+					res = append(res, IssueInfo{
+						Issue: buildIgnoreDirectiveIssue(rule, directive, name, rule.Message()),
+						Path:  path,
+					})
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// isRuleEnabled reports whether the rule or any rule of the group is enabled for the path.
+// Unknown names (e.g. rules of plugins) are treated as disabled, so their directives are never reported as unused.
+func (c *Core) isRuleEnabled(name, path string) bool {
+	names := []string{name}
+	if groupRules, ok := c.commentIgnores.Groups[name]; ok {
+		names = groupRules
+	}
+
+	for _, rule := range c.rulesFor(path) {
+		if slices.Contains(names, GetRuleName(rule)) && !c.shouldIgnore(rule, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func buildIgnoreDirectiveIssue(rule Rule, directive ignoreDirective, sourceName, message string) Issue {
+	return Issue{
+		Position:   directive.pos,
+		SourceName: sourceName,
+		Message:    message,
+		RuleName:   GetRuleName(rule),
+	}
+}
+
+// parseIgnoreDirectives returns ignore directives from comments of proto source.
+func parseIgnoreDirectives(src []byte) []ignoreDirective {
+	var res []ignoreDirective
+
+	for _, comment := range scanComments(src) {
+		for _, match := range ignoreDirectiveRegexp.FindAllStringSubmatchIndex(comment.text, -1) {
+			text := comment.text[match[0]:match[1]]
+			justification := ""
+			if match[6] >= 0 {
+				justification = comment.text[match[6]:match[7]]
+			}
+
+			rules := strings.Split(comment.text[match[4]:match[5]], ",")
+			for i := range rules {
+				rules[i] = strings.TrimSpace(rules[i])
+			}
+
+			res = append(res, ignoreDirective{
+				text:          strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), "*/")),
+				pos:           comment.position(match[0]),
+				rules:         rules,
+				justification: strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(justification), "*/")),
+				fileScope:     comment.text[match[2]:match[3]] == lintIgnoreFilePrefix,
+				fromLine:      comment.fromLine,
+				toLine:        comment.toLine,
+			})
+		}
+	}
+
+	return res
+}
+
+// sourceComment is a comment of proto source with lines of the element it belongs to.
+type sourceComment struct {
+	text     string
+	pos      meta.Position
+	fromLine int
+	toLine   int
+}
+
+// position returns position of the byte of comment text with passed index.
+func (c sourceComment) position(index int) meta.Position {
+	pos := c.pos
+	pos.Offset += index
+
+	for _, r := range c.text[:index] {
+		if r == '\n' {
+			pos.Line++
+			pos.Column = 1
+			continue
+		}
+		pos.Column++
+	}
+
+	return pos
+}
+
+// scanComments returns comments of proto source with lines of the element they belong to:
+//   - a trailing comment belongs to its line;
+//   - leading comments belong to the next statement including its body, e.g. a whole message;
+//   - other comments (e.g. in the end of a body) belong to their lines.
+func scanComments(src []byte) []sourceComment {
+	type statement struct {
+		depth    int
+		comments []int
+	}
+
+	var (
+		res         []sourceComment
+		pending     []int
+		stack       []statement
+		depth       int
+		brackets    int
+		lineHasCode bool
+		line        = 1
+		column      = 1
+	)
+
+	isStatementOpen := func() bool {
+		return len(stack) != 0 && stack[len(stack)-1].depth == depth
+	}
+	startStatement := func() {
+		if isStatementOpen() {
+			return
+		}
+
+		for _, i := range pending {
+			res[i].fromLine = line
+		}
+		stack = append(stack, statement{depth: depth, comments: pending})
+		pending = nil
+	}
+	closeStatement := func() {
+		for _, i := range stack[len(stack)-1].comments {
+			res[i].toLine = line
+		}
+		stack = stack[:len(stack)-1]
+	}
+	// skip updates line and column by passed bytes.
+	skip := func(from, to int) {
+		for ; from < to; from++ {
+			if src[from] == '\n' {
+				line++
+				column = 1
+				lineHasCode = false
+				continue
+			}
+			column++
+		}
+	}
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		next := i + 1
+
+		switch {
+		case c == '/' && next < len(src) && (src[next] == '/' || src[next] == '*'):
+			next = len(src)
+			if src[i+1] == '/' {
+				if j := bytes.IndexByte(src[i:], '\n'); j >= 0 {
+					next = i + j
+				}
+			} else if j := bytes.Index(src[i+2:], []byte("*/")); j >= 0 {
+				next = i + 2 + j + 2
+			}
+
+			comment := sourceComment{
+				text:     string(src[i:next]),
+				pos:      meta.Position{Offset: i, Line: line, Column: column},
+				fromLine: line,
+			}
+			trailing := lineHasCode
+
+			skip(i, next)
+			i = next
+
+			comment.toLine = line
+			res = append(res, comment)
+
+			if !trailing && brackets == 0 && !isStatementOpen() {
+				pending = append(pending, len(res)-1)
+			}
+
+			continue
+		case c == '"' || c == '\'':
+			lineHasCode = true
+			startStatement()
+
+			for next < len(src) && src[next] != c && src[next] != '\n' {
+				if src[next] == '\\' {
+					next++
+				}
+				next++
+			}
+			next = min(next+1, len(src))
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		case c == '(' || c == '[':
+			lineHasCode = true
+			startStatement()
+			brackets++
+		case c == ')' || c == ']':
+			lineHasCode = true
+			brackets = max(brackets-1, 0)
+		case brackets > 0:
+			lineHasCode = true
+		case c == ';':
+			lineHasCode = true
+			if isStatementOpen() {
+				closeStatement()
+			}
+		case c == '{':
+			lineHasCode = true
+			startStatement()
+			depth++
+		case c == '}':
+			lineHasCode = true
+			pending = nil
+			depth = max(depth-1, 0)
+			for len(stack) != 0 && stack[len(stack)-1].depth >= depth {
+				closeStatement()
+			}
+		default:
+			lineHasCode = true
+			startStatement()
+		}
+
+		skip(i, next)
+		i = next
+	}
+
+	for len(stack) != 0 {
+		closeStatement()
+	}
+
+	return res
+}
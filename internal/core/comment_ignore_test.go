@@ -0,0 +1,258 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+var _ Rule = (*testMessageRule)(nil)
+
+// testMessageRule reports every message of the file including nested ones.
+type testMessageRule struct{}
+
+func (r *testMessageRule) Message() string {
+	return "message"
+}
+
+func (r *testMessageRule) Validate(protoInfo ProtoInfo) ([]Issue, error) {
+	var (
+		res  []Issue
+		walk func(messages []*unordered.Message)
+	)
+
+	walk = func(messages []*unordered.Message) {
+		for _, message := range messages {
+			res = AppendIssue(res, r, message.Meta.Pos, message.MessageName)
+			walk(message.MessageBody.Messages)
+		}
+	}
+	walk(protoInfo.Info.ProtoBody.Messages)
+
+	return res, nil
+}
+
+var _ Rule = (*testFieldRule)(nil)
+
+// testFieldRule reports every field of the file including fields of nested messages.
+type testFieldRule struct{}
+
+func (r *testFieldRule) Message() string {
+	return "field"
+}
+
+func (r *testFieldRule) Validate(protoInfo ProtoInfo) ([]Issue, error) {
+	var (
+		res  []Issue
+		walk func(messages []*unordered.Message)
+	)
+
+	walk = func(messages []*unordered.Message) {
+		for _, message := range messages {
+			for _, field := range message.MessageBody.Fields {
+				res = AppendIssue(res, r, field.Meta.Pos, field.FieldName)
+			}
+			walk(message.MessageBody.Messages)
+		}
+	}
+	walk(protoInfo.Info.ProtoBody.Messages)
+
+	return res, nil
+}
+
+var _ IgnoreDirectivesRule = (*testUnusedRule)(nil)
+
+type testUnusedRule struct{}
+
+func (r *testUnusedRule) IgnoreDirectives() {}
+
+func (r *testUnusedRule) Message() string {
+	return "unused"
+}
+
+func (r *testUnusedRule) Validate(ProtoInfo) ([]Issue, error) {
+	return nil, nil
+}
+
+func TestCore_lintFiles_CommentIgnores(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cfg  CommentIgnoresConfig
+		want []string
+	}{
+		"disabled": {
+			cfg: CommentIgnoresConfig{Allow: false},
+			want: []string{
+				"no_lint/generated.proto:6 TEST_MESSAGE_RULE FileIgnore message",
+				"no_lint/generated.proto:7 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:6 TEST_MESSAGE_RULE BufIgnore message",
+				"no_lint/messages.proto:7 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:12 TEST_MESSAGE_RULE ListIgnore message",
+				"no_lint/messages.proto:13 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:15 TEST_MESSAGE_RULE Nested message",
+				"no_lint/messages.proto:16 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:21 TEST_MESSAGE_RULE GroupIgnore message",
+				"no_lint/messages.proto:22 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:25 TEST_MESSAGE_RULE TrailingIgnore message",
+				"no_lint/messages.proto:26 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:30 TEST_MESSAGE_RULE Empty message",
+				"no_lint/messages.proto:33 TEST_MESSAGE_RULE Reported message",
+				"no_lint/messages.proto:34 TEST_FIELD_RULE name field",
+			},
+		},
+		"allowed": {
+			cfg: CommentIgnoresConfig{
+				Allow:  true,
+				Groups: map[string][]string{"TEST": {"TEST_MESSAGE_RULE", "TEST_FIELD_RULE"}},
+			},
+			want: []string{
+				"no_lint/generated.proto:7 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:7 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:26 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:29 TEST_UNUSED_RULE TEST_FIELD_RULE unused",
+				"no_lint/messages.proto:30 TEST_MESSAGE_RULE Empty message",
+				"no_lint/messages.proto:33 TEST_MESSAGE_RULE Reported message",
+			},
+		},
+		"require_justification": {
+			cfg: CommentIgnoresConfig{
+				Allow:                true,
+				RequireJustification: true,
+				Groups:               map[string][]string{"TEST": {"TEST_MESSAGE_RULE", "TEST_FIELD_RULE"}},
+			},
+			want: []string{
+				"no_lint/generated.proto:7 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:5 TEST_UNUSED_RULE buf:lint:ignore TEST_MESSAGE_RULE " +
+					`ignore directive must have a justification after "--"`,
+				"no_lint/messages.proto:6 TEST_MESSAGE_RULE BufIgnore message",
+				"no_lint/messages.proto:7 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:26 TEST_FIELD_RULE name field",
+				"no_lint/messages.proto:29 TEST_UNUSED_RULE TEST_FIELD_RULE unused",
+				"no_lint/messages.proto:30 TEST_MESSAGE_RULE Empty message",
+				"no_lint/messages.proto:32 TEST_UNUSED_RULE nolint:TEST_FIELD_RULE " +
+					`ignore directive must have a justification after "--"`,
+				"no_lint/messages.proto:33 TEST_MESSAGE_RULE Reported message",
+				"no_lint/messages.proto:34 TEST_FIELD_RULE name field",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Core{
+				rules:          []Rule{&testMessageRule{}, &testFieldRule{}, &testUnusedRule{}},
+				commentIgnores: tc.cfg,
+				logger:         logger.NewNop(),
+			}
+
+			res, err := c.lintFiles(context.Background(), fs.NewFSWalker(testdataDir, "no_lint"))
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(res))
+			for _, issue := range res {
+				got = append(got, fmt.Sprintf("%s:%d %s %s %s",
+					issue.Path, issue.Position.Line, issue.RuleName, issue.SourceName, issue.Message,
+				))
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseIgnoreDirectives(t *testing.T) {
+	t.Parallel()
+
+	type directive struct {
+		rules            []string
+		justification    string
+		fileScope        bool
+		fromLine, toLine int
+	}
+
+	tests := map[string]struct {
+		src  string
+		want []directive
+	}{
+		"no_directives": {
+			src: "// some message\nmessage A {}\n",
+		},
+		"easyp_and_buf": {
+			src: "// nolint:ENUM_VALUE_PREFIX\n// buf:lint:ignore DIRECTORY_SAME_PACKAGE\nenum A {\n  A_UNSPECIFIED = 0;\n}\n",
+			want: []directive{
+				{rules: []string{"ENUM_VALUE_PREFIX"}, fromLine: 3, toLine: 5},
+				{rules: []string{"DIRECTORY_SAME_PACKAGE"}, fromLine: 3, toLine: 5},
+			},
+		},
+		"directive_in_the_middle_of_comment": {
+			src: "// Legacy service - buf:lint:ignore SERVICE_SUFFIX\nservice UserAPI {}\n",
+			want: []directive{
+				{rules: []string{"SERVICE_SUFFIX"}, fromLine: 2, toLine: 2},
+			},
+		},
+		"list_with_justification": {
+			src: "message A {\n  // nolint:COMMENT_FIELD, FIELD_LOWER_SNAKE_CASE -- wire compatibility\n  string userName = 1;\n}\n",
+			want: []directive{
+				{
+					rules:         []string{"COMMENT_FIELD", "FIELD_LOWER_SNAKE_CASE"},
+					justification: "wire compatibility",
+					fromLine:      3,
+					toLine:        3,
+				},
+			},
+		},
+		"trailing": {
+			src: "message A {\n  string userName = 1; // nolint:FIELD_LOWER_SNAKE_CASE\n  string b = 2;\n}\n",
+			want: []directive{
+				{rules: []string{"FIELD_LOWER_SNAKE_CASE"}, fromLine: 2, toLine: 2},
+			},
+		},
+		"block_comment": {
+			src: "/* nolint:COMMENTS -- generated */\nmessage A {\n  string a = 1 [(opt) = {a: 1}];\n}\n",
+			want: []directive{
+				{rules: []string{"COMMENTS"}, justification: "generated", fromLine: 2, toLine: 4},
+			},
+		},
+		"ignore_file": {
+			src: "// easyp:lint:ignore-file PACKAGE_DEFINED -- generated\nsyntax = \"proto3\";\n",
+			want: []directive{
+				{rules: []string{"PACKAGE_DEFINED"}, justification: "generated", fileScope: true, fromLine: 2, toLine: 2},
+			},
+		},
+		"dangling_in_the_end_of_body": {
+			src: "message A {\n  string a = 1;\n  // nolint:COMMENT_FIELD\n}\n",
+			want: []directive{
+				{rules: []string{"COMMENT_FIELD"}, fromLine: 3, toLine: 3},
+			},
+		},
+		"comment_like_string": {
+			src: "option go_package = \"a//nolint:COMMENT_FIELD\";\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []directive
+			for _, d := range parseIgnoreDirectives([]byte(tc.src)) {
+				got = append(got, directive{
+					rules:         d.rules,
+					justification: d.justification,
+					fileScope:     d.fileScope,
+					fromLine:      d.fromLine,
+					toLine:        d.toLine,
+				})
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
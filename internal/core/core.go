@@ -11,19 +11,23 @@ import (
 
 // Core provide to business logic of EasyP.
 type Core struct {
-	rules        []Rule
-	ignore       []string
-	deps         []string
-	ignoreOnly   map[string][]string
-	logger       logger.Logger
-	plugins      []Plugin
-	inputs       Inputs
-	console      console.Console
-	storage      Storage
-	moduleConfig ModuleConfig
-	lockFile     LockFile
-	managedMode  ManagedModeConfig
-	vendorDir    string
+	rules          []Rule
+	lintOverrides  []LintOverride
+	ignore         []string
+	deps           []string
+	ignoreOnly     map[string][]string
+	severity       map[string]Severity
+	commentIgnores CommentIgnoresConfig
+	lintPlugins    []LintPlugin
+	logger         logger.Logger
+	plugins        []Plugin
+	inputs         Inputs
+	console        console.Console
+	storage        Storage
+	moduleConfig   ModuleConfig
+	lockFile       LockFile
+	managedMode    ManagedModeConfig
+	vendorDir      string
 
 	breakingCheckConfig     BreakingCheckConfig
 	currentProjectGitWalker CurrentProjectGitWalker
@@ -32,19 +36,26 @@ type Core struct {
 	remoteExecutor  plugin.Executor
 	builtinExecutor plugin.Executor
 	commandExecutor plugin.Executor
+	lintExecutor    *plugin.LintExecutor
 }
 
 var (
 	ErrInvalidRule            = errors.New("invalid rule")
 	ErrRepositoryDoesNotExist = errors.New("repository does not exist")
 	ErrEmptyInputFiles        = errors.New("empty input files")
+	ErrInvalidSeverity        = errors.New("invalid severity")
+	ErrNoVersionTag           = errors.New("no version tag")
 )
 
 func New(
 	rules []Rule,
+	lintOverrides []LintOverride,
 	ignore []string,
 	deps []string,
 	ignoreOnly map[string][]string,
+	severity map[string]Severity,
+	commentIgnores CommentIgnoresConfig,
+	lintPlugins []LintPlugin,
 	logger logger.Logger,
 	plugins []Plugin,
 	inputs Inputs,
@@ -59,9 +70,13 @@ func New(
 ) *Core {
 	return &Core{
 		rules:                   rules,
+		lintOverrides:           lintOverrides,
 		ignore:                  ignore,
 		deps:                    deps,
 		ignoreOnly:              ignoreOnly,
+		severity:                severity,
+		commentIgnores:          commentIgnores,
+		lintPlugins:             lintPlugins,
 		logger:                  logger,
 		plugins:                 plugins,
 		inputs:                  inputs,
@@ -76,6 +91,7 @@ func New(
 		remoteExecutor:          plugin.NewRemotePluginExecutor(logger),
 		builtinExecutor:         plugin.NewBuiltinPluginExecutor(logger),
 		commandExecutor:         plugin.NewCommandPluginExecutor(console, logger),
+		lintExecutor:            plugin.NewLintExecutor(console, logger),
 		vendorDir:               vendorDir,
 	}
 }
@@ -0,0 +1,306 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// printFileDescriptor prints the file descriptor as proto source, so it can be read like files from disk.
+// Only elements compared by breaking check are printed: package, imports, go and java packages,
+// messages, fields, oneofs, reserved ranges, enums and services. Type names are shortened to the
+// names visible from the scope of the field, as they are usually written by hand,
+// or printed fully-qualified if the short name would be resolved to another type.
+func printFileDescriptor(fd *descriptorpb.FileDescriptorProto) string {
+	p := &descriptorPrinter{pkg: fd.GetPackage(), types: make(map[string]bool)}
+
+	for _, message := range fd.GetMessageType() {
+		p.addTypes(fd.GetPackage(), message)
+	}
+	for _, enum := range fd.GetEnumType() {
+		p.types[getProtoEntityPath(fd.GetPackage(), enum.GetName())] = true
+	}
+
+	switch fd.GetSyntax() {
+	case "editions":
+		p.line(0, "edition = %q;", strings.TrimPrefix(fd.GetEdition().String(), "EDITION_"))
+		p.editions = true
+	case "proto3":
+		p.line(0, `syntax = "proto3";`)
+		p.proto3 = true
+	default:
+		p.line(0, `syntax = "proto2";`)
+	}
+
+	if fd.GetPackage() != "" {
+		p.line(0, "package %s;", fd.GetPackage())
+	}
+
+	for i, dependency := range fd.GetDependency() {
+		switch {
+		case slices.Contains(fd.GetPublicDependency(), int32(i)):
+			p.line(0, "import public %q;", dependency)
+		case slices.Contains(fd.GetWeakDependency(), int32(i)):
+			p.line(0, "import weak %q;", dependency)
+		default:
+			p.line(0, "import %q;", dependency)
+		}
+	}
+
+	if goPackage := fd.GetOptions().GetGoPackage(); goPackage != "" {
+		p.line(0, "option go_package = %q;", goPackage)
+	}
+	if javaPackage := fd.GetOptions().GetJavaPackage(); javaPackage != "" {
+		p.line(0, "option java_package = %q;", javaPackage)
+	}
+
+	for _, message := range fd.GetMessageType() {
+		p.message(0, fd.GetPackage(), message)
+	}
+
+	for _, enum := range fd.GetEnumType() {
+		p.enum(0, enum)
+	}
+
+	for _, service := range fd.GetService() {
+		p.line(0, "service %s {", service.GetName())
+		for _, method := range service.GetMethod() {
+			p.line(1, "rpc %s(%s%s) returns (%s%s);",
+				method.GetName(),
+				streamKeyword(method.GetClientStreaming()), p.typeName(fd.GetPackage(), method.GetInputType()),
+				streamKeyword(method.GetServerStreaming()), p.typeName(fd.GetPackage(), method.GetOutputType()),
+			)
+		}
+		p.line(0, "}")
+	}
+
+	return p.b.String()
+}
+
+type descriptorPrinter struct {
+	b   strings.Builder
+	pkg string
+	// types are fully-qualified names of messages and enums declared in the file.
+	types    map[string]bool
+	proto3   bool
+	editions bool
+}
+
+// addTypes collects names of the message and its nested messages and enums.
+func (p *descriptorPrinter) addTypes(scope string, message *descriptorpb.DescriptorProto) {
+	scope = getProtoEntityPath(scope, message.GetName())
+	p.types[scope] = true
+
+	for _, nested := range message.GetNestedType() {
+		p.addTypes(scope, nested)
+	}
+	for _, enum := range message.GetEnumType() {
+		p.types[getProtoEntityPath(scope, enum.GetName())] = true
+	}
+}
+
+func (p *descriptorPrinter) line(indent int, format string, args ...any) {
+	p.b.WriteString(strings.Repeat("  ", indent))
+	p.b.WriteString(fmt.Sprintf(format, args...))
+	p.b.WriteString("\n")
+}
+
+func (p *descriptorPrinter) message(indent int, scope string, message *descriptorpb.DescriptorProto) {
+	scope = getProtoEntityPath(scope, message.GetName())
+	mapEntries := make(map[string]*descriptorpb.DescriptorProto)
+
+	p.line(indent, "message %s {", message.GetName())
+
+	for _, nested := range message.GetNestedType() {
+		if nested.GetOptions().GetMapEntry() {
+			mapEntries[getProtoEntityPath(scope, nested.GetName())] = nested
+			continue
+		}
+
+		p.message(indent+1, scope, nested)
+	}
+
+	for _, enum := range message.GetEnumType() {
+		p.enum(indent+1, enum)
+	}
+
+	oneOfFields := make(map[int32][]*descriptorpb.FieldDescriptorProto)
+	for _, field := range message.GetField() {
+		if field.OneofIndex != nil && !field.GetProto3Optional() {
+			oneOfFields[field.GetOneofIndex()] = append(oneOfFields[field.GetOneofIndex()], field)
+			continue
+		}
+
+		if mapEntry, ok := mapEntries[strings.TrimPrefix(field.GetTypeName(), ".")]; ok {
+			key, value := mapEntry.GetField()[0], mapEntry.GetField()[1]
+			p.line(indent+1, "map<%s, %s> %s = %d%s;",
+				p.fieldType(scope, key), p.fieldType(scope, value),
+				field.GetName(), field.GetNumber(), fieldOptions(field),
+			)
+			continue
+		}
+
+		p.line(indent+1, "%s%s %s = %d%s;",
+			p.fieldLabel(field), p.fieldType(scope, field),
+			field.GetName(), field.GetNumber(), fieldOptions(field),
+		)
+	}
+
+	for i, oneOf := range message.GetOneofDecl() {
+		fields, ok := oneOfFields[int32(i)]
+		if !ok {
+			// synthetic oneof of proto3 optional field
+			continue
+		}
+
+		p.line(indent+1, "oneof %s {", oneOf.GetName())
+		for _, field := range fields {
+			p.line(indent+2, "%s %s = %d%s;",
+				p.fieldType(scope, field), field.GetName(), field.GetNumber(), fieldOptions(field),
+			)
+		}
+		p.line(indent+1, "}")
+	}
+
+	if ranges := message.GetReservedRange(); len(ranges) != 0 {
+		parts := make([]string, 0, len(ranges))
+		for _, r := range ranges {
+			// end of message reserved range is exclusive
+			parts = append(parts, reservedRange(r.GetStart(), r.GetEnd()-1, maxFieldNumber))
+		}
+		p.line(indent+1, "reserved %s;", strings.Join(parts, ", "))
+	}
+
+	p.reservedNames(indent+1, message.GetReservedName())
+
+	p.line(indent, "}")
+}
+
+func (p *descriptorPrinter) enum(indent int, enum *descriptorpb.EnumDescriptorProto) {
+	p.line(indent, "enum %s {", enum.GetName())
+
+	for _, value := range enum.GetValue() {
+		p.line(indent+1, "%s = %d;", value.GetName(), value.GetNumber())
+	}
+
+	if ranges := enum.GetReservedRange(); len(ranges) != 0 {
+		parts := make([]string, 0, len(ranges))
+		for _, r := range ranges {
+			parts = append(parts, reservedRange(r.GetStart(), r.GetEnd(), math.MaxInt32))
+		}
+		p.line(indent+1, "reserved %s;", strings.Join(parts, ", "))
+	}
+
+	p.reservedNames(indent+1, enum.GetReservedName())
+
+	p.line(indent, "}")
+}
+
+func (p *descriptorPrinter) reservedNames(indent int, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	// editions require identifiers instead of string literals
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		if p.editions {
+			parts = append(parts, name)
+		} else {
+			parts = append(parts, strconv.Quote(name))
+		}
+	}
+	p.line(indent, "reserved %s;", strings.Join(parts, ", "))
+}
+
+func (p *descriptorPrinter) fieldLabel(field *descriptorpb.FieldDescriptorProto) string {
+	switch field.GetLabel() {
+	case descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+		return "repeated "
+	case descriptorpb.FieldDescriptorProto_LABEL_REQUIRED:
+		return "required "
+	}
+
+	switch {
+	case p.editions:
+		return ""
+	case p.proto3 && !field.GetProto3Optional():
+		return ""
+	default:
+		return "optional "
+	}
+}
+
+func (p *descriptorPrinter) fieldType(scope string, field *descriptorpb.FieldDescriptorProto) string {
+	if field.GetTypeName() != "" {
+		return p.typeName(scope, field.GetTypeName())
+	}
+
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
+
+// typeName shortens fully-qualified type name to the name visible from the scope.
+// Name is printed fully-qualified if it's declared outside of the package or
+// if the first part of the short name is shadowed by a type nested in the scope,
+// e.g. pkg.Bar referenced from pkg.Foo which has nested Bar.
+func (p *descriptorPrinter) typeName(scope, typeName string) string {
+	typeName = strings.TrimPrefix(typeName, ".")
+
+	var innerScopes []string
+	for scope != "" {
+		if short, ok := strings.CutPrefix(typeName, scope+"."); ok {
+			first, _, _ := strings.Cut(short, ".")
+			for _, inner := range innerScopes {
+				if p.types[inner+"."+first] {
+					return "." + typeName
+				}
+			}
+
+			return short
+		}
+
+		if scope == p.pkg {
+			break
+		}
+		innerScopes = append(innerScopes, scope)
+
+		i := strings.LastIndex(scope, ".")
+		if i == -1 {
+			break
+		}
+		scope = scope[:i]
+	}
+
+	return "." + typeName
+}
+
+func fieldOptions(field *descriptorpb.FieldDescriptorProto) string {
+	if field.JsonName == nil || field.GetJsonName() == fieldJSONName(field.GetName(), nil) {
+		return ""
+	}
+
+	return fmt.Sprintf(" [json_name = %q]", field.GetJsonName())
+}
+
+func reservedRange(start, end, maxNumber int32) string {
+	switch {
+	case start == end:
+		return strconv.Itoa(int(start))
+	case end >= maxNumber:
+		return fmt.Sprintf("%d to max", start)
+	default:
+		return fmt.Sprintf("%d to %d", start, end)
+	}
+}
+
+func streamKeyword(stream bool) string {
+	if stream {
+		return "stream "
+	}
+
+	return ""
+}
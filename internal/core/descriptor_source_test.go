@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+func TestPrintFileDescriptor_TypeNames(t *testing.T) {
+	t.Parallel()
+
+	const content = `syntax = "proto3";
+
+package pkg;
+
+message Bar {}
+
+message Foo {
+  message Bar {}
+
+  message Baz {
+    pkg.Bar outer = 1;
+    Bar inner = 2;
+  }
+
+  pkg.Bar outer = 1;
+  Bar inner = 2;
+  Baz baz = 3;
+}
+
+message Qux {
+  Bar bar = 1;
+  Foo.Bar foo_bar = 2;
+}
+`
+
+	c := &Core{logger: logger.NewNop()}
+
+	original := compileSource(t, c, content)
+	printed := printFileDescriptor(protodesc.ToFileDescriptorProto(original))
+	reread := compileSource(t, c, printed)
+
+	// every field must reference the same type after printing
+	require.Equal(t, fieldTypes(original.Messages()), fieldTypes(reread.Messages()))
+	require.Contains(t, printed, ".pkg.Bar outer = 1;")
+	require.Contains(t, printed, "Foo.Bar foo_bar = 2;")
+}
+
+func TestPrintFileDescriptor_ReservedNames(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content  string
+		reserved string
+	}{
+		"proto3": {
+			content: `syntax = "proto3";
+
+package pkg;
+
+message Foo {
+  reserved "bar", "baz";
+}
+
+enum Kind {
+  KIND_UNSPECIFIED = 0;
+  reserved "KIND_OLD";
+}
+`,
+			reserved: `reserved "bar", "baz";`,
+		},
+		"editions": {
+			content: `edition = "2023";
+
+package pkg;
+
+message Foo {
+  reserved bar, baz;
+}
+
+enum Kind {
+  KIND_UNSPECIFIED = 0;
+  reserved KIND_OLD;
+}
+`,
+			reserved: "reserved bar, baz;",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Core{logger: logger.NewNop()}
+
+			original := compileSource(t, c, tc.content)
+			printed := printFileDescriptor(protodesc.ToFileDescriptorProto(original))
+			reread := compileSource(t, c, printed)
+
+			require.Contains(t, printed, tc.reserved)
+			require.Equal(t,
+				original.Messages().Get(0).ReservedNames(), reread.Messages().Get(0).ReservedNames())
+			require.Equal(t,
+				original.Enums().Get(0).ReservedNames(), reread.Enums().Get(0).ReservedNames())
+		})
+	}
+}
+
+func compileSource(t *testing.T, c *Core, content string) protoreflect.FileDescriptor {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file.proto"), []byte(content), 0o644))
+
+	res, err := c.compileProtoFile(context.Background(), fs.NewFSWalker(dir, "."), "file.proto")
+	require.NoError(t, err)
+
+	return res
+}
+
+// fieldTypes returns full names of message types of fields by full names of fields.
+func fieldTypes(messages protoreflect.MessageDescriptors) map[protoreflect.FullName]protoreflect.FullName {
+	res := make(map[protoreflect.FullName]protoreflect.FullName)
+
+	for i := range messages.Len() {
+		message := messages.Get(i)
+
+		fields := message.Fields()
+		for j := range fields.Len() {
+			if fields.Get(j).Message() != nil {
+				res[fields.Get(j).FullName()] = fields.Get(j).Message().FullName()
+			}
+		}
+
+		for name, typeName := range fieldTypes(message.Messages()) {
+			res[name] = typeName
+		}
+	}
+
+	return res
+}
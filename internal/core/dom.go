@@ -10,6 +10,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/easyp-tech/easyp/internal/core/models"
 )
@@ -23,15 +24,55 @@ type (
 		Validate(ProtoInfo) ([]Issue, error)
 	}
 
+	// CrossFileRule is a rule which collects state across files, e.g. packages of directories.
+	// Such rules are run in the aggregation phase after file-local rules, sequentially and in the order of files,
+	// so they don't have to be safe for concurrent use.
+	CrossFileRule interface {
+		Rule
+		// CrossFile marks the rule as cross-file.
+		CrossFile()
+	}
+
+	// IgnoreDirectivesRule is a rule which checks comment ignore directives instead of proto elements.
+	// It is run by Core after ignore directives are applied to issues of all other rules.
+	IgnoreDirectivesRule interface {
+		Rule
+		// IgnoreDirectives marks the rule as a checker of ignore directives.
+		IgnoreDirectives()
+	}
+
+	// DocumentedRule is a rule which describes itself for users, e.g. in `easyp lint explain`.
+	DocumentedRule interface {
+		Rule
+		// Doc returns documentation of the rule.
+		Doc() RuleDoc
+	}
+
 	// CurrentProjectGitWalker is provider for fs walking for current project
 	CurrentProjectGitWalker interface {
 		GetDirWalker(workingDir, gitRef, path string) (DirWalker, error)
+		// LastVersionTag returns the nearest semver tag reachable from HEAD like `git describe --tags --abbrev=0`,
+		// ErrNoVersionTag is returned if there is no such tag.
+		LastVersionTag(workingDir string) (string, error)
 	}
 
 	// IssueInfo contains the information of an issue and the path.
 	IssueInfo struct {
 		Issue
 		Path string
+		// Scope locates element of the issue, it is set by breaking check to group issues in report.
+		Scope IssueScope `json:",omitzero"`
+		// Element is a fully qualified path of the element with the issue, e.g. foo.v1.User.id.
+		// It is set by lint and identifies the issue in lint baseline.
+		Element string `json:",omitempty"`
+	}
+
+	// IssueScope contains package, service and message of the issue element.
+	// Service and message are empty for file level elements, e.g. imports and top level enums.
+	IssueScope struct {
+		Package string `json:"package"`
+		Service string `json:"service,omitempty"`
+		Message string `json:"message,omitempty"`
 	}
 
 	// Issue contains the information of an issue.
@@ -40,6 +81,24 @@ type (
 		SourceName string
 		Message    string
 		RuleName   string
+		Severity   Severity
+		// Hint suggests how to fix the issue, it is set by breaking check.
+		Hint string `json:",omitempty"`
+	}
+
+	// Severity is a level of an issue.
+	Severity string
+
+	// RuleDoc is documentation of a rule.
+	RuleDoc struct {
+		// Description explains what the rule checks.
+		Description string `json:"description"`
+		// Bad is a proto example violating the rule.
+		Bad string `json:"bad"`
+		// Good is a proto example satisfying the rule.
+		Good string `json:"good"`
+		// Options contains config keys which change behavior of the rule, e.g. "lint.service_suffix".
+		Options []string `json:"options,omitempty"`
 	}
 
 	// ImportPath type alias for path import in proto file
@@ -53,6 +112,16 @@ type (
 		Path                 string
 		Info                 *unordered.Proto
 		ProtoFilesFromImport map[ImportPath]*unordered.Proto
+		// Descriptor is compiled and linked proto file with resolved types and source info.
+		// It is nil if file could not be compiled (e.g. it has unresolved types),
+		// so rules have to fall back to Info in that case.
+		Descriptor protoreflect.FileDescriptor
+	}
+
+	File struct {
+		ProtoFilePath string
+		PackageName   PackageName
+		*unordered.Proto
 	}
 
 	Import struct {
@@ -65,6 +134,8 @@ type (
 		ProtoFilePath string
 		PackageName   PackageName
 		*unordered.Service
+		// Descriptor is compiled service, it is nil if its file could not be compiled.
+		Descriptor protoreflect.ServiceDescriptor
 	}
 
 	Message struct {
@@ -72,6 +143,8 @@ type (
 		ProtoFilePath string
 		PackageName   PackageName
 		*unordered.Message
+		// Descriptor is compiled message, it is nil if its file could not be compiled.
+		Descriptor protoreflect.MessageDescriptor
 	}
 
 	OneOf struct {
@@ -89,6 +162,8 @@ type (
 	}
 
 	Collection struct {
+		// Files contains checked files, files which are only imported are not included.
+		Files    map[string]File
 		Imports  map[ImportPath]Import
 		Services map[string]Service
 		// key message path - for supporting nested messages:
@@ -106,6 +181,39 @@ type (
 	ProtoData map[PackageName]*Collection
 )
 
+// Severity levels of issues.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// ParseSeverity parses severity level.
+func ParseSeverity(s string) (Severity, error) {
+	switch severity := Severity(s); severity {
+	case SeverityError, SeverityWarning, SeverityInfo:
+		return severity, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidSeverity, s)
+	}
+}
+
+// AtLeast reports whether severity is not lower than passed one.
+func (s Severity) AtLeast(severity Severity) bool {
+	return s.rank() >= severity.rank()
+}
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityInfo:
+		return 0
+	case SeverityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
 type Repo interface {
 	// GetFiles returns list of all files in repository
 	GetFiles(ctx context.Context, revision models.Revision, dirs ...string) ([]string, error)
@@ -134,18 +242,21 @@ func GetPackageName(protoFile *unordered.Proto) PackageName {
 	return PackageName(protoFile.ProtoBody.Packages[0].Name)
 }
 
-// AppendIssue check if lint error is ignored -> add new error to slice
-// otherwise ignore appending
-func AppendIssue(
-	issues []Issue, lintRule Rule, pos meta.Position, sourceName string, comments []*parser.Comment,
-) []Issue {
-	if CheckIsIgnored(comments, GetRuleName(lintRule)) {
-		return issues
-	}
-
+// AppendIssue adds new issue of the rule to slice.
+// Comment ignore directives are applied by Core after all rules are run.
+func AppendIssue(issues []Issue, lintRule Rule, pos meta.Position, sourceName string) []Issue {
 	return append(issues, buildError(lintRule, pos, sourceName))
 }
 
+// AppendIssueWithMessage the same as AppendIssue but uses passed message instead of the rule's one,
+// it is used by rules which can report several kinds of issues.
+func AppendIssueWithMessage(issues []Issue, lintRule Rule, pos meta.Position, sourceName, message string) []Issue {
+	issue := buildError(lintRule, pos, sourceName)
+	issue.Message = message
+
+	return append(issues, issue)
+}
+
 // GetRuleName returns rule name
 func GetRuleName(rule Rule) string {
 	return toUpperSnakeCase(reflect.TypeOf(rule).Elem().Name())
@@ -215,6 +326,13 @@ type (
 		Options     map[string][]string
 		WithImports bool
 	}
+	// LintPlugin is an external lint plugin, executed as local binary, command or WASM module.
+	LintPlugin struct {
+		Path    string
+		Command []string
+		Wasm    string
+		Options map[string][]string
+	}
 	// InputGitRepo is the configuration of the git repository.
 	InputGitRepo struct {
 		URL          string
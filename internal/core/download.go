@@ -23,37 +23,69 @@ func (c *Core) Download(ctx context.Context) error {
 	c.logger.Debug(ctx, "Lock file is not empty. Install deps from it")
 
 	// install from lock file at first
+	// lock file contains the whole resolved graph so dependencies are not resolved again
 	for lockFileInfo := range c.lockFile.DepsIter() {
 		module := models.NewModuleFromLockFileInfo(lockFileInfo)
 		log := c.logger.With(slog.String("module", module.Name), slog.String("version", string(module.Version)))
 
 		log.Debug(ctx, "downloading module from lockfile")
-		if err := c.Get(ctx, module); err != nil {
-			return fmt.Errorf("c.Get: %w", err)
+		installedModuleInfo, err := c.install(ctx, module)
+		if err != nil {
+			return fmt.Errorf("c.install: %w", err)
+		}
+
+		if err := c.lockFile.Write(
+			module.Name, installedModuleInfo.RevisionVersion, installedModuleInfo.Hash, lockFileInfo.Indirect,
+		); err != nil {
+			return fmt.Errorf("c.lockFile.Write: %w", err)
 		}
 	}
 
 	c.logger.Debug(ctx, "installing remaining dependencies not in lock file")
 
-	// install from remote generator sections
+	roots, complete, err := c.lockedRoots(ctx)
+	if err != nil {
+		return fmt.Errorf("c.lockedRoots: %w", err)
+	}
+
+	if complete {
+		return nil
+	}
+
+	// deps from config which are absent in lock file (or were indirect before)
+	// are resolved together with locked ones, so locked versions are kept
+	if err := c.installResolved(ctx, roots); err != nil {
+		return fmt.Errorf("c.installResolved: %w", err)
+	}
+
+	return nil
+}
+
+// lockedRoots returns deps from config as modules.
+// Version of the module is taken from lock file if it is locked as direct dependency.
+// complete is false if any of deps is not locked.
+func (c *Core) lockedRoots(ctx context.Context) (roots []models.Module, complete bool, err error) {
+	roots = make([]models.Module, 0, len(c.deps))
+	complete = true
+
 	for _, dependency := range c.deps {
 		module := models.NewModule(dependency)
 		log := c.logger.With(slog.String("module", module.Name), slog.String("version", string(module.Version)))
 
-		_, err := c.lockFile.Read(module.Name)
-		if err == nil {
+		lockFileInfo, err := c.lockFile.Read(module.Name)
+		switch {
+		case err == nil && !lockFileInfo.Indirect:
 			log.Debug(ctx, "already in lock file")
+			roots = append(roots, models.NewModuleFromLockFileInfo(lockFileInfo))
 			continue
-		}
-		if !errors.Is(err, models.ErrModuleNotFoundInLockFile) {
-			return fmt.Errorf("c.lockFile.Read: %w", err)
+		case err != nil && !errors.Is(err, models.ErrModuleNotFoundInLockFile):
+			return nil, false, fmt.Errorf("c.lockFile.Read: %w", err)
 		}
 
-		log.Debug(ctx, "downloading module from deps")
-		if err := c.Get(ctx, module); err != nil {
-			return fmt.Errorf("c.Get: %w", err)
-		}
+		log.Debug(ctx, "module is not locked")
+		roots = append(roots, module)
+		complete = false
 	}
 
-	return nil
+	return roots, complete, nil
 }
@@ -24,7 +24,13 @@ func (emptyLockFile) Read(moduleName string) (models.LockFileInfo, error) {
 	return models.LockFileInfo{}, errors.New("lock file info not found")
 }
 
-func (emptyLockFile) Write(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash) error {
+func (emptyLockFile) Write(
+	moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool,
+) error {
+	return nil
+}
+
+func (emptyLockFile) Remove(moduleName string) error {
 	return nil
 }
 
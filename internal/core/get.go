@@ -10,47 +10,40 @@ import (
 	"github.com/easyp-tech/easyp/internal/core/models"
 )
 
-// Get download package.
-func (c *Core) Get(ctx context.Context, requestedModule models.Module) error {
+// install puts package into storage if it is not installed yet without writing lock file.
+// Dependencies of the package are not installed, they have to be resolved before.
+func (c *Core) install(
+	ctx context.Context, requestedModule models.Module,
+) (models.InstalledModuleInfo, error) {
 	log := c.logger.With(slog.String("module", requestedModule.Name), slog.String("version", string(requestedModule.Version)))
 	cacheDownloadPaths := c.storage.GetCacheDownloadPaths(requestedModule.Name, string(requestedModule.Version))
 
-	var installedModuleInfo models.InstalledModuleInfo
-	var err error
-	needToInstall := false
-
-	installedModuleInfo, err = c.storage.ReadInstalledModuleInfo(cacheDownloadPaths)
+	installedModuleInfo, err := c.storage.ReadInstalledModuleInfo(cacheDownloadPaths)
 	if err != nil {
 		if !errors.Is(err, models.ErrModuleInfoFileNotFound) {
-			return fmt.Errorf("c.storage.ReadInstalledModuleInfo: %w", err)
+			return models.InstalledModuleInfo{}, fmt.Errorf("c.storage.ReadInstalledModuleInfo: %w", err)
 		}
 
-		needToInstall = true
-	}
-
-	if !needToInstall {
-		if err := c.checkInstalledPackage(ctx, requestedModule, installedModuleInfo); err != nil {
-			return fmt.Errorf("c.checkInstalledPackage: %w", err)
-		}
-
-		log.Debug(ctx, "module already installed")
-	} else {
 		installedModuleInfo, err = c.get(ctx, requestedModule)
 		if err != nil {
-			return fmt.Errorf("c.get: %w", err)
+			return models.InstalledModuleInfo{}, fmt.Errorf("c.get: %w", err)
 		}
+
+		return installedModuleInfo, nil
 	}
 
-	if err := c.lockFile.Write(
-		requestedModule.Name, installedModuleInfo.RevisionVersion, installedModuleInfo.Hash,
-	); err != nil {
-		return fmt.Errorf("c.lockFile.Write: %w", err)
+	if err := c.checkInstalledPackage(ctx, requestedModule, installedModuleInfo); err != nil {
+		return models.InstalledModuleInfo{}, fmt.Errorf("c.checkInstalledPackage: %w", err)
 	}
 
-	return nil
+	log.Debug(ctx, "module already installed")
+
+	return installedModuleInfo, nil
 }
 
-func (c *Core) get(ctx context.Context, requestedModule models.Module) (models.InstalledModuleInfo, error) {
+func (c *Core) get(
+	ctx context.Context, requestedModule models.Module,
+) (models.InstalledModuleInfo, error) {
 	cacheRepositoryDir, err := c.storage.CreateCacheRepositoryDir(requestedModule.Name)
 	if err != nil {
 		return models.InstalledModuleInfo{}, fmt.Errorf("c.storage.CreateCacheRepositoryDir: %w", err)
@@ -83,20 +76,6 @@ func (c *Core) get(ctx context.Context, requestedModule models.Module) (models.I
 
 	log := c.logger.With(slog.String("module", requestedModule.Name), slog.String("version", string(requestedModule.Version)))
 
-	for _, indirectDep := range moduleConfig.Dependencies {
-		if err := c.Get(ctx, indirectDep); err != nil {
-			if errors.Is(err, models.ErrVersionNotFound) {
-				log.Error(ctx, "indirect dependency version not found", slog.Any("dependency", indirectDep))
-				return models.InstalledModuleInfo{}, models.ErrVersionNotFound
-			}
-
-			return models.InstalledModuleInfo{}, fmt.Errorf("c.Get: %w", err)
-		}
-	}
-
-	// check package deps (that was read from repo)
-	// compare versions
-
 	if err := repo.Archive(ctx, revision, cacheDownloadPaths.ArchiveFile); err != nil {
 		return models.InstalledModuleInfo{}, fmt.Errorf("repository.Archive: %w", err)
 	}
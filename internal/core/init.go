@@ -181,9 +181,6 @@ func (c *Core) migrateFromBUF(ctx context.Context, disk FS, path string, default
 	if len(b.Build.Excludes) > 0 {
 		c.logger.Warn(ctx, "buf build.excludes is not supported in easyp, skipping")
 	}
-	if len(b.Breaking.Use) > 0 {
-		c.logger.Warn(ctx, "buf breaking.use granular rules not yet supported, using default breaking check")
-	}
 
 	migratedCfg := buildCfgFromBUF(defaultConfiguration, b)
 
@@ -228,10 +225,18 @@ func buildCfgFromBUF(cfg config.Config, bufConfig BUFConfig) config.Config {
 			AllowCommentIgnores: bufConfig.Lint.AllowCommentIgnores,
 			EnumZeroValueSuffix: bufConfig.Lint.EnumZeroValueSuffix,
 			ServiceSuffix:       bufConfig.Lint.ServiceSuffix,
+
+			RPCAllowSameRequestResponse:          bufConfig.Lint.RPCAllowSameRequestResponse,
+			RPCAllowGoogleProtobufEmptyRequests:  bufConfig.Lint.RPCAllowGoogleProtobufEmptyRequests,
+			RPCAllowGoogleProtobufEmptyResponses: bufConfig.Lint.RPCAllowGoogleProtobufEmptyResponses,
 		},
 		BreakingCheck: config.BreakingCheck{
-			AgainstGitRef: cfg.BreakingCheck.AgainstGitRef,
-			Ignore:        bufConfig.Breaking.Ignore,
+			AgainstGitRef:          cfg.BreakingCheck.AgainstGitRef,
+			Use:                    bufConfig.Breaking.Use,
+			Except:                 bufConfig.Breaking.Except,
+			Ignore:                 bufConfig.Breaking.Ignore,
+			IgnoreOnly:             bufConfig.Breaking.IgnoreOnly,
+			IgnoreUnstablePackages: bufConfig.Breaking.IgnoreUnstablePackages,
 		},
 	}
 
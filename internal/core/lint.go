@@ -1,14 +1,21 @@
 package core
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"fmt"
 	"io"
 	"log/slog"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
 
-	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/easyp-tech/easyp/internal/core/path_helpers"
 )
@@ -21,7 +28,45 @@ func (c *Core) Lint(ctx context.Context, fsWalker DirWalker) ([]IssueInfo, error
 		return nil, fmt.Errorf("c.Download: %w", err)
 	}
 
-	var res []IssueInfo
+	res, err := c.lintFiles(ctx, fsWalker)
+	if err != nil {
+		return nil, fmt.Errorf("c.lintFiles: %w", err)
+	}
+
+	c.logger.Info(ctx, "lint completed", slog.Int("issues", len(res)))
+
+	return res, nil
+}
+
+// LintOverride contains rules for files matching the path instead of base rules.
+type LintOverride struct {
+	// Path is a dir, file or doublestar glob of files.
+	Path  string
+	Rules []Rule
+}
+
+// lintedFile is a result of the file-local phase of linting.
+type lintedFile struct {
+	protoInfo  ProtoInfo
+	issues     []IssueInfo
+	directives []ignoreDirective
+}
+
+// lintFiles lints all proto files from fsWalker.
+// Files are read, compiled and checked by file-local rules on a worker pool,
+// then cross-file rules and plugins are run in the aggregation phase
+// and issues disabled by comment ignore directives are removed.
+// Issues are sorted by path, line and column.
+func (c *Core) lintFiles(ctx context.Context, fsWalker DirWalker) ([]IssueInfo, error) {
+	_, res, err := c.lint(ctx, fsWalker)
+
+	return res, err
+}
+
+// lint lints all proto files from fsWalker, see lintFiles.
+// Linted files are returned with issues, e.g. for reading their packages.
+func (c *Core) lint(ctx context.Context, fsWalker DirWalker) ([]lintedFile, []IssueInfo, error) {
+	var paths []string
 
 	err := fsWalker.WalkDir(func(path string, err error) error {
 		switch {
@@ -35,99 +80,272 @@ func (c *Core) Lint(ctx context.Context, fsWalker DirWalker) ([]IssueInfo, error
 			return nil
 		}
 
-		protoInfo, err := c.protoInfoRead(ctx, fsWalker, path)
-		if err != nil {
-			return fmt.Errorf("c.protoInfoRead: %w", err)
+		paths = append(paths, path)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("fs.WalkDir: %w", err)
+	}
+
+	files := make([]lintedFile, len(paths))
+	disk := &syncFS{FS: fsWalker}
+
+	// files are compiled before linting sharing compiled imports
+	descriptors := make([]linker.Result, len(paths))
+	compiler := c.newProtoCompiler(disk)
+	for i, path := range paths {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
 		}
 
-		for i := range c.rules {
-			if ctx.Err() != nil {
-				return ctx.Err()
+		descriptor, err := compiler.compile(ctx, path)
+		if err != nil {
+			// file still can be linted by rules which don't require descriptors,
+			// but lint plugins take descriptors only
+			if len(c.lintPlugins) != 0 {
+				c.logger.Warn(ctx, "failed to compile proto file, it is excluded from lint plugins",
+					slog.String("path", path), slog.Any("error", err))
+			} else {
+				c.logger.Debug(ctx, "failed to compile proto file", slog.String("path", path), slog.Any("error", err))
 			}
+			continue
+		}
 
-			if c.shouldIgnore(c.rules[i], path) {
-				continue
-			}
+		descriptors[i] = descriptor
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.GOMAXPROCS(0))
 
-			results, err := c.rules[i].Validate(protoInfo)
+	for i, path := range paths {
+		g.Go(func() error {
+			localRules, _ := splitRules(c.rulesFor(path))
+
+			file, err := c.lintFile(gCtx, disk, path, descriptors[i], localRules)
 			if err != nil {
-				return fmt.Errorf("rule.Validate: %w", err)
+				return fmt.Errorf("c.lintFile: %w", err)
 			}
 
-			for _, result := range results {
-				res = append(res, IssueInfo{
-					Issue: result,
-					Path:  path,
-				})
-			}
+			files[i] = file
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		res      []IssueInfo
+		compiled []linker.Result
+	)
+
+	for _, file := range files {
+		res = append(res, file.issues...)
+
+		_, crossFileRules := splitRules(c.rulesFor(file.protoInfo.Path))
+
+		issues, err := c.runRules(ctx, file.protoInfo, crossFileRules)
+		if err != nil {
+			return nil, nil, err
 		}
+		res = append(res, issues...)
 
-		return nil
-	})
+		if descriptor, ok := file.protoInfo.Descriptor.(linker.Result); ok {
+			compiled = append(compiled, descriptor)
+		}
+	}
+
+	pluginIssues, err := c.runLintPlugins(ctx, compiled)
 	if err != nil {
-		return nil, fmt.Errorf("fs.WalkDir: %w", err)
+		return nil, nil, fmt.Errorf("c.runLintPlugins: %w", err)
 	}
+	res = append(res, pluginIssues...)
 
-	c.logger.Info(ctx, "lint completed", slog.Int("issues", len(res)))
+	setIssueElements(files, res)
 
-	return res, nil
+	res = c.applyIgnoreDirectives(files, res)
+
+	for i := range res {
+		res[i].Severity = c.ruleSeverity(res[i].RuleName)
+	}
+
+	sortIssues(res)
+
+	return files, res, nil
 }
 
-func (c *Core) shouldIgnore(rule Rule, path string) bool {
-	ruleName := GetRuleName(rule)
-	ignoreFilesOrDirs := c.ignoreOnly[ruleName]
+// rulesFor returns rules for the file: rules of the last lint override matching the path
+// or base rules if there are no such overrides.
+func (c *Core) rulesFor(path string) []Rule {
+	for i := len(c.lintOverrides) - 1; i >= 0; i-- {
+		override := c.lintOverrides[i]
+		if path_helpers.MatchPath(override.Path, path) {
+			return override.Rules
+		}
+	}
 
-	for _, fileOrDir := range ignoreFilesOrDirs {
-		switch {
-		case fileOrDir == path:
-			return true
-		case strings.HasPrefix(path, fileOrDir):
-			return true
+	return c.rules
+}
+
+// splitRules splits rules to file-local and cross-file ones.
+func splitRules(rules []Rule) (localRules, crossFileRules []Rule) {
+	for _, rule := range rules {
+		if _, ok := rule.(CrossFileRule); ok {
+			crossFileRules = append(crossFileRules, rule)
+		} else {
+			localRules = append(localRules, rule)
 		}
 	}
 
-	return false
+	return localRules, crossFileRules
 }
 
-func (c *Core) close(ctx context.Context, f io.Closer, path string) {
-	err := f.Close()
+// lintFile reads proto file and checks it by passed file-local rules.
+// Descriptor is nil if the file can't be compiled.
+func (c *Core) lintFile(
+	ctx context.Context, disk FS, path string, descriptor linker.Result, rules []Rule,
+) (lintedFile, error) {
+	protoInfo, err := c.protoInfoRead(ctx, disk, path)
 	if err != nil {
-		c.logger.Warn(
-			ctx,
-			"failed to close file",
-			slog.Any("error", err),
-			slog.String("path", path),
-		)
+		return lintedFile{}, fmt.Errorf("c.protoInfoRead: %w", err)
+	}
+
+	if descriptor != nil {
+		protoInfo.Descriptor = descriptor
+	}
+
+	issues, err := c.runRules(ctx, protoInfo, rules)
+	if err != nil {
+		return lintedFile{}, err
+	}
+
+	directives, err := c.readIgnoreDirectives(ctx, disk, path)
+	if err != nil {
+		return lintedFile{}, fmt.Errorf("c.readIgnoreDirectives: %w", err)
 	}
+
+	return lintedFile{
+		protoInfo:  protoInfo,
+		issues:     issues,
+		directives: directives,
+	}, nil
 }
 
-const (
-	// for backward compatibility with buf
-	bufLintIgnorePrefix = "buf:lint:ignore "
-	lintIgnorePrefix    = "nolint:"
-)
+func (c *Core) runRules(ctx context.Context, protoInfo ProtoInfo, rules []Rule) ([]IssueInfo, error) {
+	var res []IssueInfo
 
-// NOTE: Try to not use global var
-var allowCommentIgnores = true
+	for i := range rules {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if c.shouldIgnore(rules[i], protoInfo.Path) {
+			continue
+		}
 
-// CheckIsIgnored check if passed breakingCheckRuleName has to be ignored due to ignore command in comments
-func CheckIsIgnored(comments []*parser.Comment, ruleName string) bool {
-	if !allowCommentIgnores {
-		return false
+		results, err := rules[i].Validate(protoInfo)
+		if err != nil {
+			return nil, fmt.Errorf("rule.Validate: %w", err)
+		}
+
+		for _, result := range results {
+			res = append(res, IssueInfo{
+				Issue: result,
+				Path:  protoInfo.Path,
+			})
+		}
 	}
 
-	if len(comments) == 0 {
-		return false
+	return res, nil
+}
+
+// setIssueElements sets elements of issues by their positions in linted files.
+func setIssueElements(files []lintedFile, issues []IssueInfo) {
+	infos := make(map[string]*unordered.Proto, len(files))
+	for _, file := range files {
+		infos[file.protoInfo.Path] = file.protoInfo.Info
 	}
 
-	bufIgnore := bufLintIgnorePrefix + ruleName
-	easypIgnore := lintIgnorePrefix + ruleName
+	for i := range issues {
+		issues[i].Element = issueElement(infos[issues[i].Path], issues[i].Position)
+	}
+}
 
-	for _, comment := range comments {
-		if strings.Contains(comment.Raw, bufIgnore) {
+// sortIssues sorts issues by path, line and column,
+// issues with the same position keep the order of rules.
+func sortIssues(issues []IssueInfo) {
+	slices.SortStableFunc(issues, func(a, b IssueInfo) int {
+		return cmp.Or(
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Position.Line, b.Position.Line),
+			cmp.Compare(a.Position.Column, b.Position.Column),
+		)
+	})
+}
+
+// syncFS serializes reading from the underlying FS:
+// not every FS is safe for concurrent use (e.g. git tree).
+type syncFS struct {
+	FS
+	mu sync.Mutex
+}
+
+func (s *syncFS) Open(name string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *syncFS) Exists(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.FS.Exists(name)
+}
+
+// ruleSeverity returns configured severity of the rule, issues are errors by default.
+func (c *Core) ruleSeverity(ruleName string) Severity {
+	if severity, ok := c.severity[ruleName]; ok {
+		return severity
+	}
+
+	return SeverityError
+}
+
+func (c *Core) shouldIgnore(rule Rule, path string) bool {
+	return c.shouldIgnoreRuleName(GetRuleName(rule), path)
+}
+
+func (c *Core) shouldIgnoreRuleName(ruleName, path string) bool {
+	return isIgnoredOnly(c.ignoreOnly[ruleName], path)
+}
+
+// isIgnoredOnly reports whether the path is matched by files, dirs or globs of ignore_only of a rule.
+func isIgnoredOnly(ignoreFilesOrDirs []string, path string) bool {
+	for _, fileOrDir := range ignoreFilesOrDirs {
+		switch {
+		case fileOrDir == path:
 			return true
-		}
-		if strings.Contains(comment.Raw, easypIgnore) {
+		case path_helpers.IsGlob(fileOrDir):
+			if path_helpers.MatchGlob(fileOrDir, path) {
+				return true
+			}
+		case strings.HasPrefix(path, fileOrDir):
 			return true
 		}
 	}
@@ -135,6 +353,14 @@ func CheckIsIgnored(comments []*parser.Comment, ruleName string) bool {
 	return false
 }
 
-func SetAllowCommentIgnores(val bool) {
-	allowCommentIgnores = val
+func (c *Core) close(ctx context.Context, f io.Closer, path string) {
+	err := f.Close()
+	if err != nil {
+		c.logger.Warn(
+			ctx,
+			"failed to close file",
+			slog.Any("error", err),
+			slog.String("path", path),
+		)
+	}
 }
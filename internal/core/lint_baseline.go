@@ -0,0 +1,117 @@
+package core
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+)
+
+// LintBaselineVersion is the current version of lint baseline format.
+// Version 2 identifies issues by their elements.
+const LintBaselineVersion = 2
+
+type (
+	// LintBaseline contains known lint issues which are not reported.
+	LintBaseline struct {
+		Version int                 `json:"version"`
+		Issues  []LintBaselineIssue `json:"issues"`
+	}
+
+	// LintBaselineIssue is a known lint issue.
+	// It is keyed by path, rule and fingerprint of the element instead of position,
+	// so baseline survives changes which only move elements in the file.
+	LintBaselineIssue struct {
+		Path        string `json:"path"`
+		RuleName    string `json:"rule"`
+		Fingerprint string `json:"fingerprint"`
+		// Element, SourceName and Message are stored for readability only.
+		Element    string `json:"element,omitempty"`
+		SourceName string `json:"source_name,omitempty"`
+		Message    string `json:"message,omitempty"`
+	}
+)
+
+// NewLintBaseline builds baseline from passed issues.
+func NewLintBaseline(issues []IssueInfo) LintBaseline {
+	res := LintBaseline{
+		Version: LintBaselineVersion,
+		Issues:  make([]LintBaselineIssue, 0, len(issues)),
+	}
+
+	for _, issue := range issues {
+		res.Issues = append(res.Issues, LintBaselineIssue{
+			Path:        issue.Path,
+			RuleName:    issue.RuleName,
+			Fingerprint: IssueFingerprint(issue),
+			Element:     issue.Element,
+			SourceName:  issue.SourceName,
+			Message:     issue.Message,
+		})
+	}
+
+	slices.SortStableFunc(res.Issues, func(a, b LintBaselineIssue) int {
+		return cmp.Or(
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.RuleName, b.RuleName),
+			cmp.Compare(a.Fingerprint, b.Fingerprint),
+		)
+	})
+
+	return res
+}
+
+// IssueFingerprint returns stable fingerprint of the element with issue.
+// Position is not a part of fingerprint, so it doesn't change when lines are added or removed above the element,
+// but fully qualified element is, so issues of elements with the same name in different messages are distinguished.
+func IssueFingerprint(issue IssueInfo) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(issue.Element))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(issue.SourceName))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(issue.Message))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Filter returns issues which are not in the baseline and baseline issues which were not found anymore.
+// Every baseline issue matches one issue at most, so new issues on elements
+// with the same fingerprint are still reported.
+func (b LintBaseline) Filter(issues []IssueInfo) ([]IssueInfo, []LintBaselineIssue) {
+	type key struct {
+		path        string
+		ruleName    string
+		fingerprint string
+	}
+
+	known := make(map[key][]LintBaselineIssue, len(b.Issues))
+	for _, issue := range b.Issues {
+		k := key{issue.Path, issue.RuleName, issue.Fingerprint}
+		known[k] = append(known[k], issue)
+	}
+
+	var newIssues []IssueInfo
+	for _, issue := range issues {
+		k := key{issue.Path, issue.RuleName, IssueFingerprint(issue)}
+		if len(known[k]) == 0 {
+			newIssues = append(newIssues, issue)
+			continue
+		}
+
+		known[k] = known[k][1:]
+	}
+
+	var stale []LintBaselineIssue
+	for _, issue := range b.Issues {
+		k := key{issue.Path, issue.RuleName, issue.Fingerprint}
+		if len(known[k]) == 0 {
+			continue
+		}
+
+		stale = append(stale, known[k][0])
+		known[k] = known[k][1:]
+	}
+
+	return newIssues, stale
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+func TestLintBaseline_Filter(t *testing.T) {
+	t.Parallel()
+
+	issue := func(path, rule, sourceName string, line int) IssueInfo {
+		return IssueInfo{
+			Element: "foo.User." + sourceName,
+			Issue: Issue{
+				Position:   meta.Position{Line: line, Column: 1},
+				SourceName: sourceName,
+				Message:    "comment must not be empty",
+				RuleName:   rule,
+			},
+			Path: path,
+		}
+	}
+
+	baseline := NewLintBaseline([]IssueInfo{
+		issue("a.proto", "COMMENT_FIELD", "id", 10),
+		issue("a.proto", "COMMENT_FIELD", "id", 20),
+		issue("a.proto", "COMMENT_MESSAGE", "User", 5),
+		issue("b.proto", "COMMENT_FIELD", "name", 3),
+	})
+
+	tests := map[string]struct {
+		issues    []IssueInfo
+		wantNew   []IssueInfo
+		wantStale []string
+	}{
+		"nothing_changed": {
+			issues: []IssueInfo{
+				issue("a.proto", "COMMENT_FIELD", "id", 10),
+				issue("a.proto", "COMMENT_FIELD", "id", 20),
+				issue("a.proto", "COMMENT_MESSAGE", "User", 5),
+				issue("b.proto", "COMMENT_FIELD", "name", 3),
+			},
+		},
+		"lines_moved": {
+			issues: []IssueInfo{
+				issue("a.proto", "COMMENT_FIELD", "id", 15),
+				issue("a.proto", "COMMENT_FIELD", "id", 25),
+				issue("a.proto", "COMMENT_MESSAGE", "User", 7),
+				issue("b.proto", "COMMENT_FIELD", "name", 1),
+			},
+		},
+		"new_issues": {
+			issues: []IssueInfo{
+				issue("a.proto", "COMMENT_FIELD", "id", 10),
+				issue("a.proto", "COMMENT_FIELD", "id", 20),
+				issue("a.proto", "COMMENT_FIELD", "id", 30),
+				issue("a.proto", "COMMENT_MESSAGE", "User", 5),
+				issue("b.proto", "COMMENT_FIELD", "name", 3),
+				issue("b.proto", "COMMENT_FIELD", "email", 4),
+				issue("c.proto", "COMMENT_FIELD", "name", 3),
+			},
+			wantNew: []IssueInfo{
+				issue("a.proto", "COMMENT_FIELD", "id", 30),
+				issue("b.proto", "COMMENT_FIELD", "email", 4),
+				issue("c.proto", "COMMENT_FIELD", "name", 3),
+			},
+		},
+		"same_name_in_other_message": {
+			issues: []IssueInfo{
+				issue("a.proto", "COMMENT_FIELD", "id", 10),
+				issue("a.proto", "COMMENT_FIELD", "id", 20),
+				issue("a.proto", "COMMENT_MESSAGE", "User", 5),
+				issue("b.proto", "COMMENT_FIELD", "name", 3),
+				{
+					Issue:   issue("b.proto", "COMMENT_FIELD", "name", 30).Issue,
+					Path:    "b.proto",
+					Element: "foo.Admin.name",
+				},
+			},
+			wantNew: []IssueInfo{
+				{
+					Issue:   issue("b.proto", "COMMENT_FIELD", "name", 30).Issue,
+					Path:    "b.proto",
+					Element: "foo.Admin.name",
+				},
+			},
+		},
+		"stale_issues": {
+			issues: []IssueInfo{
+				issue("a.proto", "COMMENT_FIELD", "id", 10),
+			},
+			wantStale: []string{"id", "User", "name"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			newIssues, stale := baseline.Filter(tc.issues)
+			require.Equal(t, tc.wantNew, newIssues)
+
+			var staleNames []string
+			for _, issue := range stale {
+				staleNames = append(staleNames, issue.SourceName)
+			}
+			require.Equal(t, tc.wantStale, staleNames)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// LintChanges lints proto files and reports only issues of files changed against git ref:
+//   - issues of added and modified files;
+//   - issues of cross-file rules in files of packages with changed files,
+//     e.g. a changed go_package makes other files of the package inconsistent.
+//
+// If onlyChangedLines is true, only issues on added or modified lines are reported.
+func (c *Core) LintChanges(
+	ctx context.Context, fsWalker DirWalker, workingDir, path, gitRef string, onlyChangedLines bool,
+) ([]IssueInfo, error) {
+	c.logger.Info(ctx, "starting lint of changes", slog.String("against", gitRef))
+
+	if err := c.Download(ctx); err != nil {
+		return nil, fmt.Errorf("c.Download: %w", err)
+	}
+
+	againstWalker, err := c.currentProjectGitWalker.GetDirWalker(workingDir, gitRef, path)
+	if err != nil {
+		return nil, fmt.Errorf("c.currentProjectGitWalker.GetDirWalker: %w", err)
+	}
+
+	res, err := c.lintChanges(ctx, fsWalker, againstWalker, onlyChangedLines)
+	if err != nil {
+		return nil, fmt.Errorf("c.lintChanges: %w", err)
+	}
+
+	c.logger.Info(ctx, "lint of changes completed", slog.Int("issues", len(res)))
+
+	return res, nil
+}
+
+// lintChanges lints files of fsWalker and filters issues by changes against files of againstWalker, see LintChanges.
+func (c *Core) lintChanges(
+	ctx context.Context, fsWalker DirWalker, againstWalker FS, onlyChangedLines bool,
+) ([]IssueInfo, error) {
+	files, issues, err := c.lint(ctx, fsWalker)
+	if err != nil {
+		return nil, fmt.Errorf("c.lint: %w", err)
+	}
+
+	var (
+		changes         = make(map[string]fileChange, len(files))
+		packages        = make(map[string]PackageName, len(files))
+		changedPackages = make(map[PackageName]bool)
+	)
+
+	for _, file := range files {
+		packages[file.protoInfo.Path] = GetPackageName(file.protoInfo.Info)
+
+		change, err := c.readFileChange(ctx, fsWalker, againstWalker, file.protoInfo.Path)
+		if err != nil {
+			return nil, fmt.Errorf("c.readFileChange: %w", err)
+		}
+		if !change.changed() {
+			continue
+		}
+
+		changes[file.protoInfo.Path] = change
+		changedPackages[packages[file.protoInfo.Path]] = true
+	}
+
+	res := issues[:0]
+	for _, issue := range issues {
+		change, changed := changes[issue.Path]
+
+		switch {
+		case onlyChangedLines:
+			if !changed || !change.hasLine(issue.Position.Line) {
+				continue
+			}
+		case !changed:
+			if !c.isCrossFileRule(issue.RuleName, issue.Path) || !changedPackages[packages[issue.Path]] {
+				continue
+			}
+		}
+
+		res = append(res, issue)
+	}
+
+	c.logger.Debug(ctx, "changed files", slog.Int("count", len(changes)))
+
+	return res, nil
+}
+
+// isCrossFileRule reports whether the rule enabled for the path is a cross-file rule.
+func (c *Core) isCrossFileRule(ruleName, path string) bool {
+	for _, rule := range c.rulesFor(path) {
+		if _, ok := rule.(CrossFileRule); ok && GetRuleName(rule) == ruleName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileChange contains lines of the current version of the file changed against git ref.
+type fileChange struct {
+	// added is true if the file doesn't exist in git ref, so all its lines are changed.
+	added bool
+	// lines contains numbers of added or modified lines.
+	lines map[int]bool
+}
+
+func (f fileChange) changed() bool {
+	return f.added || len(f.lines) != 0
+}
+
+func (f fileChange) hasLine(line int) bool {
+	return f.added || f.lines[line]
+}
+
+// readFileChange reads the file from both walkers and returns its changed lines.
+func (c *Core) readFileChange(ctx context.Context, current, against FS, path string) (fileChange, error) {
+	if !against.Exists(path) {
+		return fileChange{added: true}, nil
+	}
+
+	currentContent, err := c.readFile(ctx, current, path)
+	if err != nil {
+		return fileChange{}, fmt.Errorf("c.readFile: %w", err)
+	}
+
+	againstContent, err := c.readFile(ctx, against, path)
+	if err != nil {
+		return fileChange{}, fmt.Errorf("c.readFile: %w", err)
+	}
+
+	return fileChange{lines: changedLines(againstContent, currentContent)}, nil
+}
+
+func (c *Core) readFile(ctx context.Context, disk FS, path string) (string, error) {
+	f, err := disk.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("disk.Open: %w", err)
+	}
+	defer c.close(ctx, f, path)
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return "", fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	return string(content), nil
+}
+
+// changedLines returns numbers of lines of current text which are added or modified against the old one.
+// A line following removed lines is treated as modified, e.g. removed comment affects the next element.
+func changedLines(old, current string) map[int]bool {
+	res := make(map[int]bool)
+	if old == current {
+		return res
+	}
+
+	dmp := diffmatchpatch.New()
+	oldChars, currentChars, lines := dmp.DiffLinesToChars(old, current)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(oldChars, currentChars, false), lines)
+
+	line := 1
+	for _, diff := range diffs {
+		count := strings.Count(diff.Text, "\n")
+		if !strings.HasSuffix(diff.Text, "\n") {
+			count++
+		}
+
+		switch diff.Type {
+		case diffmatchpatch.DiffEqual:
+			line += count
+		case diffmatchpatch.DiffInsert:
+			for i := range count {
+				res[line+i] = true
+			}
+			line += count
+		case diffmatchpatch.DiffDelete:
+			res[line] = true
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+func TestCore_lintChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		onlyChangedLines bool
+		want             []string
+	}{
+		"changed_files": {
+			want: []string{
+				"new/e.proto:5 TEST_MESSAGE_RULE F",
+				"pkg/a.proto:5 TEST_MESSAGE_RULE A",
+				"pkg/a.proto:7 TEST_MESSAGE_RULE B",
+				"pkg/b.proto:3 TEST_SEEN_PACKAGE_RULE pkg",
+			},
+		},
+		"changed_lines": {
+			onlyChangedLines: true,
+			want: []string{
+				"new/e.proto:5 TEST_MESSAGE_RULE F",
+				"pkg/a.proto:7 TEST_MESSAGE_RULE B",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Core{
+				rules:  []Rule{&testSeenPackageRule{seen: make(map[string]bool)}, &testMessageRule{}},
+				logger: logger.NewNop(),
+			}
+
+			dir := filepath.Join(testdataDir, "lint_changes")
+			res, err := c.lintChanges(
+				context.Background(),
+				fs.NewFSWalker(filepath.Join(dir, "current"), "."),
+				fs.NewFSWalker(filepath.Join(dir, "against"), "."),
+				tc.onlyChangedLines,
+			)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(res))
+			for _, issue := range res {
+				got = append(got, fmt.Sprintf("%s:%d %s %s",
+					issue.Path, issue.Position.Line, issue.RuleName, issue.SourceName,
+				))
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestChangedLines(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		old, current string
+		want         map[int]bool
+	}{
+		"equal": {
+			old:     "a\nb\n",
+			current: "a\nb\n",
+			want:    map[int]bool{},
+		},
+		"added": {
+			old:     "a\nb\n",
+			current: "a\nc\nd\nb\n",
+			want:    map[int]bool{2: true, 3: true},
+		},
+		"modified": {
+			old:     "a\nb\nc\n",
+			current: "a\nB\nc\n",
+			want:    map[int]bool{2: true},
+		},
+		"removed": {
+			old:     "a\n// comment\nb\n",
+			current: "a\nb\n",
+			want:    map[int]bool{2: true},
+		},
+		"without_trailing_newline": {
+			old:     "a\nb",
+			current: "a\nb\nc",
+			want:    map[int]bool{2: true, 3: true},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, changedLines(tc.old, tc.current))
+		})
+	}
+}
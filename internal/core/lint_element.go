@@ -0,0 +1,150 @@
+package core
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+// issueElement returns fully qualified path of the innermost element of the proto file containing the position,
+// e.g. foo.v1.User.id, enum values are nested in their enums: foo.v1.Status.STATUS_OK.
+// Parsed file is used instead of descriptor, so element is found in files which can't be compiled.
+// Fields, enum values and map fields have no end position, they are matched by the first line.
+// Package name is returned for positions outside any element.
+func issueElement(info *unordered.Proto, pos meta.Position) string {
+	if info == nil || info.ProtoBody == nil {
+		return ""
+	}
+
+	scope := string(GetPackageName(info))
+	body := info.ProtoBody
+
+	for _, message := range body.Messages {
+		if containsPosition(message.Meta, pos) {
+			return messageElement(message, joinElement(scope, message.MessageName), pos)
+		}
+	}
+
+	for _, enum := range body.Enums {
+		if containsPosition(enum.Meta, pos) {
+			return enumElement(enum, joinElement(scope, enum.EnumName), pos)
+		}
+	}
+
+	for _, service := range body.Services {
+		if !containsPosition(service.Meta, pos) {
+			continue
+		}
+
+		serviceScope := joinElement(scope, service.ServiceName)
+		for _, rpc := range service.ServiceBody.RPCs {
+			if containsPosition(rpc.Meta, pos) {
+				return joinElement(serviceScope, rpc.RPCName)
+			}
+		}
+
+		return serviceScope
+	}
+
+	for _, extend := range body.Extends {
+		if !containsPosition(extend.Meta, pos) {
+			continue
+		}
+
+		for _, field := range extend.ExtendBody.Fields {
+			if field.Meta.Pos.Line == pos.Line {
+				return joinElement(scope, field.FieldName)
+			}
+		}
+
+		return scope
+	}
+
+	return scope
+}
+
+func messageElement(message *unordered.Message, scope string, pos meta.Position) string {
+	body := message.MessageBody
+
+	for _, nested := range body.Messages {
+		if containsPosition(nested.Meta, pos) {
+			return messageElement(nested, joinElement(scope, nested.MessageName), pos)
+		}
+	}
+
+	for _, enum := range body.Enums {
+		if containsPosition(enum.Meta, pos) {
+			return enumElement(enum, joinElement(scope, enum.EnumName), pos)
+		}
+	}
+
+	for _, oneof := range body.Oneofs {
+		if !containsPosition(oneof.Meta, pos) {
+			continue
+		}
+
+		// fields of oneof are fields of the message
+		for _, field := range oneof.OneofFields {
+			if field.Meta.Pos.Line == pos.Line {
+				return joinElement(scope, field.FieldName)
+			}
+		}
+
+		return joinElement(scope, oneof.OneofName)
+	}
+
+	for _, extend := range body.Extends {
+		if !containsPosition(extend.Meta, pos) {
+			continue
+		}
+
+		for _, visitee := range extend.ExtendBody {
+			if field, ok := visitee.(*parser.Field); ok && field.Meta.Pos.Line == pos.Line {
+				return joinElement(scope, field.FieldName)
+			}
+		}
+
+		return scope
+	}
+
+	for _, field := range body.Fields {
+		if field.Meta.Pos.Line == pos.Line {
+			return joinElement(scope, field.FieldName)
+		}
+	}
+
+	for _, field := range body.Maps {
+		if field.Meta.Pos.Line == pos.Line {
+			return joinElement(scope, field.MapName)
+		}
+	}
+
+	return scope
+}
+
+func enumElement(enum *unordered.Enum, scope string, pos meta.Position) string {
+	for _, value := range enum.EnumBody.EnumFields {
+		if value.Meta.Pos.Line == pos.Line {
+			return joinElement(scope, value.Ident)
+		}
+	}
+
+	return scope
+}
+
+// containsPosition reports whether the position is between the first and the last positions of the element.
+func containsPosition(m meta.Meta, pos meta.Position) bool {
+	return !positionBefore(pos, m.Pos) && !positionBefore(m.LastPos, pos)
+}
+
+func positionBefore(a, b meta.Position) bool {
+	return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+}
+
+func joinElement(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+
+	return scope + "." + name
+}
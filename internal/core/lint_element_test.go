@@ -0,0 +1,80 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+func TestIssueElement(t *testing.T) {
+	t.Parallel()
+
+	const content = `syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/empty.proto";
+
+message User {
+  string id = 1;
+
+  message Bar {
+    string id = 1;
+  }
+
+  oneof contact {
+    string email = 2;
+  }
+
+  map<string, string> labels = 3;
+
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+  }
+}
+
+enum Kind {
+  KIND_UNSPECIFIED = 0;
+}
+
+service UserService {
+  rpc Get(User) returns (User);
+}
+`
+
+	info, err := readProtoFile(strings.NewReader(content))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		line   int
+		column int
+		want   string
+	}{
+		"import":             {line: 5, column: 1, want: "foo.v1"},
+		"message":            {line: 7, column: 9, want: "foo.v1.User"},
+		"field":              {line: 8, column: 10, want: "foo.v1.User.id"},
+		"nested_message":     {line: 10, column: 11, want: "foo.v1.User.Bar"},
+		"nested_field":       {line: 11, column: 12, want: "foo.v1.User.Bar.id"},
+		"oneof_field":        {line: 15, column: 12, want: "foo.v1.User.email"},
+		"map_field":          {line: 18, column: 23, want: "foo.v1.User.labels"},
+		"nested_enum_value":  {line: 21, column: 5, want: "foo.v1.User.Status.STATUS_UNSPECIFIED"},
+		"enum":               {line: 25, column: 6, want: "foo.v1.Kind"},
+		"enum_value":         {line: 26, column: 3, want: "foo.v1.Kind.KIND_UNSPECIFIED"},
+		"rpc":                {line: 30, column: 7, want: "foo.v1.UserService.Get"},
+		"after_last_element": {line: 32, column: 1, want: "foo.v1"},
+		"closing_of_message": {line: 23, column: 1, want: "foo.v1.User"},
+		"closing_of_service": {line: 31, column: 1, want: "foo.v1.UserService"},
+		"between_fields":     {line: 9, column: 1, want: "foo.v1.User"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := issueElement(info, meta.Position{Line: tc.line, Column: tc.column})
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
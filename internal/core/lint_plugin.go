@@ -0,0 +1,139 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/easyp-tech/easyp/internal/adapters/plugin"
+)
+
+// runLintPlugins executes lint plugins against compiled proto files.
+// Issues of plugins are filtered by ignore_only the same way as issues of builtin rules.
+func (c *Core) runLintPlugins(ctx context.Context, files []linker.Result) ([]IssueInfo, error) {
+	if len(c.lintPlugins) == 0 || len(files) == 0 {
+		return nil, nil
+	}
+
+	filesByPath := make(map[string]linker.Result, len(files))
+	for _, file := range files {
+		filesByPath[file.Path()] = file
+	}
+
+	request := buildLintPluginRequest(files)
+
+	var res []IssueInfo
+
+	for _, lintPlugin := range c.lintPlugins {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		info := plugin.LintInfo{
+			Path:    lintPlugin.Path,
+			Command: lintPlugin.Command,
+			Wasm:    lintPlugin.Wasm,
+			Options: lintPlugin.Options,
+		}
+
+		issues, err := c.lintExecutor.Execute(ctx, info, proto.Clone(request).(*pluginpb.CodeGeneratorRequest))
+		if err != nil {
+			return nil, fmt.Errorf("c.lintExecutor.Execute: %w", err)
+		}
+
+		for _, issue := range issues {
+			file, ok := filesByPath[issue.Path]
+			if !ok {
+				c.logger.Warn(ctx, "lint plugin reported issue for unknown file",
+					slog.String("plugin", info.Name()),
+					slog.String("path", issue.Path),
+					slog.String("rule", issue.Rule),
+				)
+				continue
+			}
+
+			if c.shouldIgnoreRuleName(issue.Rule, issue.Path) {
+				continue
+			}
+
+			pos, sourceName := lintPluginIssueLocation(file, issue)
+
+			res = append(res, IssueInfo{
+				Issue: Issue{
+					Position:   pos,
+					SourceName: sourceName,
+					Message:    issue.Message,
+					RuleName:   issue.Rule,
+				},
+				Path: issue.Path,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// lintPluginIssueLocation resolves position and source name of the element reported by plugin.
+func lintPluginIssueLocation(file linker.Result, issue plugin.LintIssue) (meta.Position, string) {
+	var (
+		pos        meta.Position
+		sourceName = issue.Element
+	)
+
+	if issue.Element != "" {
+		if d := file.FindDescriptorByName(protoreflect.FullName(issue.Element)); d != nil {
+			pos = DescriptorPosition(d)
+			sourceName = string(d.Name())
+		}
+	}
+
+	if issue.Line != 0 {
+		pos = meta.Position{
+			Line:   issue.Line,
+			Column: issue.Column,
+		}
+	}
+
+	pos.Filename = issue.Path
+
+	return pos, sourceName
+}
+
+// buildLintPluginRequest builds request for lint plugins:
+// proto_file contains passed files with all their imports in topological order,
+// file_to_generate contains passed files.
+func buildLintPluginRequest(files []linker.Result) *pluginpb.CodeGeneratorRequest {
+	request := &pluginpb.CodeGeneratorRequest{}
+	seen := make(map[string]struct{})
+
+	for _, file := range files {
+		request.FileToGenerate = append(request.FileToGenerate, file.Path())
+		request.ProtoFile = appendFileDescriptorProtos(request.ProtoFile, seen, file)
+	}
+
+	return request
+}
+
+func appendFileDescriptorProtos(
+	res []*descriptorpb.FileDescriptorProto, seen map[string]struct{}, file protoreflect.FileDescriptor,
+) []*descriptorpb.FileDescriptorProto {
+	if _, ok := seen[file.Path()]; ok {
+		return res
+	}
+	seen[file.Path()] = struct{}{}
+
+	imports := file.Imports()
+	for i := range imports.Len() {
+		res = appendFileDescriptorProtos(res, seen, imports.Get(i).FileDescriptor)
+	}
+
+	return append(res, protodesc.ToFileDescriptorProto(file))
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	pluginexecutor "github.com/easyp-tech/easyp/internal/adapters/plugin"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+const lintPluginDir = "../../testdata/lint_plugin"
+
+// lintPluginConsole imitates lint plugin: it stores request and returns prepared response.
+type lintPluginConsole struct {
+	response string
+	request  *pluginpb.CodeGeneratorRequest
+	command  []string
+}
+
+func (c *lintPluginConsole) RunCmd(context.Context, string, string, ...string) (string, error) {
+	return "", nil
+}
+
+func (c *lintPluginConsole) RunCmdWithStdin(
+	_ context.Context, _ string, stdin io.Reader, command string, commandParams ...string,
+) (string, error) {
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", err
+	}
+
+	c.request = &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(data, c.request); err != nil {
+		return "", err
+	}
+	c.command = append([]string{command}, commandParams...)
+
+	return c.response, nil
+}
+
+func TestCore_runLintPlugins(t *testing.T) {
+	t.Parallel()
+
+	const path = "acme/v1/user.proto"
+
+	response := `{"issues":[
+		{"path":"acme/v1/user.proto","element":"acme.v1.User.id","rule":"ACME_FIELD_NAME","message":"id field is forbidden"},
+		{"path":"acme/v1/user.proto","element":"acme.v1.User.legacy_name","rule":"ACME_FIELD_NAME","message":"legacy field"},
+		{"path":"acme/v1/user.proto","line":7,"column":1,"rule":"ACME_MESSAGE","message":"message is too small"},
+		{"path":"acme/v1/user.proto","element":"acme.v1.User","rule":"ACME_IGNORED","message":"ignored by ignore_only"},
+		{"path":"acme/v1/unknown.proto","rule":"ACME_MESSAGE","message":"unknown file"}
+	]}`
+
+	tests := map[string]struct {
+		plugin     LintPlugin
+		response   string
+		wantIssues []IssueInfo
+		wantErr    bool
+	}{
+		"issues": {
+			plugin: LintPlugin{
+				Command: []string{"acme-lint", "--strict"},
+				Options: map[string][]string{"max_fields": {"10"}},
+			},
+			response: response,
+			wantIssues: []IssueInfo{
+				{
+					Issue: Issue{
+						Position:   meta.Position{Filename: path, Offset: 98, Line: 8, Column: 3},
+						SourceName: "id",
+						Message:    "id field is forbidden",
+						RuleName:   "ACME_FIELD_NAME",
+					},
+					Path: path,
+				},
+				{
+					Issue: Issue{
+						Position: meta.Position{Filename: path, Line: 7, Column: 1},
+						Message:  "message is too small",
+						RuleName: "ACME_MESSAGE",
+					},
+					Path: path,
+				},
+			},
+		},
+		"plugin_error": {
+			plugin: LintPlugin{
+				Command: []string{"acme-lint", "--strict"},
+			},
+			response: `{"error":"unknown option"}`,
+			wantErr:  true,
+		},
+		"issue_without_rule": {
+			plugin: LintPlugin{
+				Command: []string{"acme-lint", "--strict"},
+			},
+			response: `{"issues":[{"path":"acme/v1/user.proto","message":"no rule"}]}`,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pluginConsole := &lintPluginConsole{response: tc.response}
+			c := &Core{
+				logger:       logger.NewNop(),
+				lintPlugins:  []LintPlugin{tc.plugin},
+				lintExecutor: pluginexecutor.NewLintExecutor(pluginConsole, logger.NewNop()),
+				ignoreOnly:   map[string][]string{"ACME_IGNORED": {"acme/v1"}},
+				commentIgnores: CommentIgnoresConfig{
+					Allow: true,
+				},
+			}
+			disk := fs.NewFSWalker(lintPluginDir, ".")
+
+			descriptor, err := c.compileProtoFile(context.Background(), disk, path)
+			require.NoError(t, err)
+
+			issues, err := c.runLintPlugins(context.Background(), []linker.Result{descriptor})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// issues of plugins are ignored by comment directives the same way as issues of builtin rules
+			directives, err := c.readIgnoreDirectives(context.Background(), disk, path)
+			require.NoError(t, err)
+			issues = c.applyIgnoreDirectives([]lintedFile{{protoInfo: ProtoInfo{Path: path}, directives: directives}}, issues)
+			require.Equal(t, tc.wantIssues, issues)
+
+			require.Equal(t, tc.plugin.Command, pluginConsole.command)
+			require.Equal(t, "max_fields=10", pluginConsole.request.GetParameter())
+			require.Equal(t, []string{path}, pluginConsole.request.GetFileToGenerate())
+
+			protoFiles := make([]string, 0, len(pluginConsole.request.GetProtoFile()))
+			for _, file := range pluginConsole.request.GetProtoFile() {
+				protoFiles = append(protoFiles, file.GetName())
+			}
+			require.Equal(t, []string{"google/protobuf/timestamp.proto", path}, protoFiles)
+		})
+	}
+}
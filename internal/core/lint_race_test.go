@@ -0,0 +1,86 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/core/mocks"
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+// TestLint_ImportUsedConcurrently lints many files which don't compile,
+// so ImportUsed checks them by names concurrently. Run with -race.
+// Test isn't parallel: files are linted by GOMAXPROCS workers which is raised for single CPU machines.
+func TestLint_ImportUsedConcurrently(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	const filesCount = 32
+
+	dir := t.TempDir()
+	writeFile(t, dir, "common.proto", `syntax = "proto3";
+
+package common;
+
+message Common {}
+`)
+
+	wantPaths := make(map[string]bool)
+	for i := range filesCount {
+		field := "common.Common common = 2;"
+		if i%2 != 0 {
+			field = ""
+			wantPaths[fmt.Sprintf("file_%d.proto", i)] = true
+		}
+
+		writeFile(t, dir, fmt.Sprintf("file_%d.proto", i), fmt.Sprintf(`syntax = "proto3";
+
+package file%d;
+
+import "common.proto";
+
+message File {
+  Missing missing = 1;
+  %s
+}
+`, i, field))
+	}
+
+	lockFile := mocks.NewLockFile(t)
+	lockFile.EXPECT().IsEmpty().Return(false)
+	lockFile.EXPECT().DepsIter().Return(func(yield func(models.LockFileInfo) bool) {})
+
+	app := core.New(
+		[]core.Rule{&rules.ImportUsed{}},
+		nil, nil, nil, nil, nil, core.CommentIgnoresConfig{}, nil,
+		logger.NewNop(), nil, core.Inputs{}, nil, nil, nil, lockFile, nil,
+		core.BreakingCheckConfig{}, core.ManagedModeConfig{}, "",
+	)
+
+	issues, err := app.Lint(context.Background(), fs.NewFSWalker(dir, "."))
+	require.NoError(t, err)
+
+	gotPaths := make(map[string]bool)
+	for _, issue := range issues {
+		require.Equal(t, "IMPORT_USED", issue.RuleName)
+		require.Equal(t, `"common.proto"`, issue.SourceName)
+		gotPaths[issue.Path] = true
+	}
+	require.Len(t, issues, len(wantPaths))
+	require.Equal(t, wantPaths, gotPaths)
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
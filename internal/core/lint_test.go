@@ -0,0 +1,186 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+const testdataDir = "../../testdata"
+
+var _ Rule = (*testImportRule)(nil)
+
+// testImportRule reports every import of the file.
+type testImportRule struct{}
+
+func (r *testImportRule) Message() string {
+	return "import"
+}
+
+func (r *testImportRule) Validate(protoInfo ProtoInfo) ([]Issue, error) {
+	var res []Issue
+	for _, imp := range protoInfo.Info.ProtoBody.Imports {
+		res = AppendIssue(res, r, imp.Meta.Pos, imp.Location)
+	}
+
+	return res, nil
+}
+
+var _ CrossFileRule = (*testSeenPackageRule)(nil)
+
+// testSeenPackageRule reports package of the file if package was already seen in previous files.
+type testSeenPackageRule struct {
+	mu    sync.Mutex
+	seen  map[string]bool
+	paths []string
+}
+
+func (r *testSeenPackageRule) CrossFile() {}
+
+func (r *testSeenPackageRule) Message() string {
+	return "package already seen"
+}
+
+func (r *testSeenPackageRule) Validate(protoInfo ProtoInfo) ([]Issue, error) {
+	// rule has to be called sequentially, lock is only used for detecting concurrent calls
+	if !r.mu.TryLock() {
+		panic("cross-file rule is called concurrently")
+	}
+	defer r.mu.Unlock()
+
+	r.paths = append(r.paths, protoInfo.Path)
+
+	var res []Issue
+	for _, pkg := range protoInfo.Info.ProtoBody.Packages {
+		if r.seen[pkg.Name] {
+			res = AppendIssue(res, r, pkg.Meta.Pos, pkg.Name)
+		}
+		r.seen[pkg.Name] = true
+	}
+
+	return res, nil
+}
+
+func TestCore_lintFiles(t *testing.T) {
+	t.Parallel()
+
+	wantPaths := []string{
+		"import_cycle/a/v1/a.proto",
+		"import_cycle/a/v1/d.proto",
+		"import_cycle/b/v1/b.proto",
+		"import_cycle/b/v1/c.proto",
+	}
+
+	var firstRes []IssueInfo
+
+	for range 10 {
+		crossFileRule := &testSeenPackageRule{seen: make(map[string]bool)}
+		c := &Core{
+			rules:  []Rule{crossFileRule, &testImportRule{}},
+			logger: logger.NewNop(),
+		}
+
+		res, err := c.lintFiles(context.Background(), fs.NewFSWalker(testdataDir, "import_cycle"))
+		require.NoError(t, err)
+
+		require.Equal(t, wantPaths, crossFileRule.paths)
+		require.True(t, slices.IsSortedFunc(res, func(a, b IssueInfo) int {
+			return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Position.Line, b.Position.Line))
+		}))
+
+		if firstRes == nil {
+			firstRes = res
+			continue
+		}
+		require.Equal(t, firstRes, res)
+	}
+
+	ruleNames := make(map[string][]string)
+	for _, issue := range firstRes {
+		ruleNames[issue.Path] = append(ruleNames[issue.Path], issue.RuleName)
+	}
+	require.Equal(t, map[string][]string{
+		"import_cycle/a/v1/a.proto": {"TEST_IMPORT_RULE"},
+		"import_cycle/a/v1/d.proto": {"TEST_SEEN_PACKAGE_RULE"},
+		"import_cycle/b/v1/c.proto": {"TEST_SEEN_PACKAGE_RULE", "TEST_IMPORT_RULE"},
+	}, ruleNames)
+}
+
+func TestCore_lintFiles_PathPatterns(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ignore        []string
+		ignoreOnly    map[string][]string
+		lintOverrides func(crossFileRule Rule) []LintOverride
+		want          map[string][]string
+	}{
+		"base": {
+			want: map[string][]string{
+				"import_cycle/a/v1/a.proto": {"TEST_IMPORT_RULE"},
+				"import_cycle/a/v1/d.proto": {"TEST_SEEN_PACKAGE_RULE"},
+				"import_cycle/b/v1/c.proto": {"TEST_SEEN_PACKAGE_RULE", "TEST_IMPORT_RULE"},
+			},
+		},
+		"ignore_glob": {
+			ignore: []string{"**/b/*"},
+			want: map[string][]string{
+				"import_cycle/a/v1/a.proto": {"TEST_IMPORT_RULE"},
+				"import_cycle/a/v1/d.proto": {"TEST_SEEN_PACKAGE_RULE"},
+			},
+		},
+		"ignore_only_glob": {
+			ignoreOnly: map[string][]string{"TEST_IMPORT_RULE": {"import_cycle/**/a.proto"}},
+			want: map[string][]string{
+				"import_cycle/a/v1/d.proto": {"TEST_SEEN_PACKAGE_RULE"},
+				"import_cycle/b/v1/c.proto": {"TEST_SEEN_PACKAGE_RULE", "TEST_IMPORT_RULE"},
+			},
+		},
+		"override": {
+			lintOverrides: func(crossFileRule Rule) []LintOverride {
+				return []LintOverride{
+					{Path: "import_cycle/*/v1/c.proto", Rules: []Rule{&testImportRule{}}},
+					{Path: "import_cycle/b/**", Rules: []Rule{crossFileRule}},
+				}
+			},
+			want: map[string][]string{
+				"import_cycle/a/v1/a.proto": {"TEST_IMPORT_RULE"},
+				"import_cycle/a/v1/d.proto": {"TEST_SEEN_PACKAGE_RULE"},
+				"import_cycle/b/v1/c.proto": {"TEST_SEEN_PACKAGE_RULE"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			crossFileRule := &testSeenPackageRule{seen: make(map[string]bool)}
+			c := &Core{
+				rules:      []Rule{crossFileRule, &testImportRule{}},
+				ignore:     tc.ignore,
+				ignoreOnly: tc.ignoreOnly,
+				logger:     logger.NewNop(),
+			}
+			if tc.lintOverrides != nil {
+				c.lintOverrides = tc.lintOverrides(crossFileRule)
+			}
+
+			res, err := c.lintFiles(context.Background(), fs.NewFSWalker(testdataDir, "import_cycle"))
+			require.NoError(t, err)
+
+			ruleNames := make(map[string][]string)
+			for _, issue := range res {
+				ruleNames[issue.Path] = append(ruleNames[issue.Path], issue.RuleName)
+			}
+			require.Equal(t, tc.want, ruleNames)
+		})
+	}
+}
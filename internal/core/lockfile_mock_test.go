@@ -170,17 +170,63 @@ func (_c *LockFileMock_Read_Call) RunAndReturn(run func(string) (models.LockFile
 	return _c
 }
 
-// Write provides a mock function with given fields: moduleName, revisionVersion, installedPackageHash
-func (_m *LockFileMock) Write(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash) error {
-	ret := _m.Called(moduleName, revisionVersion, installedPackageHash)
+// Remove provides a mock function with given fields: moduleName
+func (_m *LockFileMock) Remove(moduleName string) error {
+	ret := _m.Called(moduleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(moduleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockFileMock_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type LockFileMock_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - moduleName string
+func (_e *LockFileMock_Expecter) Remove(moduleName interface{}) *LockFileMock_Remove_Call {
+	return &LockFileMock_Remove_Call{Call: _e.mock.On("Remove", moduleName)}
+}
+
+func (_c *LockFileMock_Remove_Call) Run(run func(moduleName string)) *LockFileMock_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *LockFileMock_Remove_Call) Return(_a0 error) *LockFileMock_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LockFileMock_Remove_Call) RunAndReturn(run func(string) error) *LockFileMock_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: moduleName, revisionVersion, installedPackageHash, indirect
+func (_m *LockFileMock) Write(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool) error {
+	ret := _m.Called(moduleName, revisionVersion, installedPackageHash, indirect)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Write")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, models.ModuleHash) error); ok {
-		r0 = rf(moduleName, revisionVersion, installedPackageHash)
+	if rf, ok := ret.Get(0).(func(string, string, models.ModuleHash, bool) error); ok {
+		r0 = rf(moduleName, revisionVersion, installedPackageHash, indirect)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -197,13 +243,14 @@ type LockFileMock_Write_Call struct {
 //   - moduleName string
 //   - revisionVersion string
 //   - installedPackageHash models.ModuleHash
-func (_e *LockFileMock_Expecter) Write(moduleName interface{}, revisionVersion interface{}, installedPackageHash interface{}) *LockFileMock_Write_Call {
-	return &LockFileMock_Write_Call{Call: _e.mock.On("Write", moduleName, revisionVersion, installedPackageHash)}
+//   - indirect bool
+func (_e *LockFileMock_Expecter) Write(moduleName interface{}, revisionVersion interface{}, installedPackageHash interface{}, indirect interface{}) *LockFileMock_Write_Call {
+	return &LockFileMock_Write_Call{Call: _e.mock.On("Write", moduleName, revisionVersion, installedPackageHash, indirect)}
 }
 
-func (_c *LockFileMock_Write_Call) Run(run func(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash)) *LockFileMock_Write_Call {
+func (_c *LockFileMock_Write_Call) Run(run func(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool)) *LockFileMock_Write_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(models.ModuleHash))
+		run(args[0].(string), args[1].(string), args[2].(models.ModuleHash), args[3].(bool))
 	})
 	return _c
 }
@@ -213,7 +260,7 @@ func (_c *LockFileMock_Write_Call) Return(_a0 error) *LockFileMock_Write_Call {
 	return _c
 }
 
-func (_c *LockFileMock_Write_Call) RunAndReturn(run func(string, string, models.ModuleHash) error) *LockFileMock_Write_Call {
+func (_c *LockFileMock_Write_Call) RunAndReturn(run func(string, string, models.ModuleHash, bool) error) *LockFileMock_Write_Call {
 	_c.Call.Return(run)
 	return _c
 }
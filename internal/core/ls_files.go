@@ -65,6 +65,8 @@ type lsContext struct {
 	rootSource  map[string]FileSource
 	seenFiles   map[string]struct{}
 	fileRoot    map[string]string
+	importedBy  map[string]string
+	excluded    []string
 	files       []FileInfo
 	errors      []ErrorInfo
 }
@@ -75,6 +77,7 @@ func newLsContext() *lsContext {
 		rootSource:  make(map[string]FileSource),
 		seenFiles:   make(map[string]struct{}),
 		fileRoot:    make(map[string]string),
+		importedBy:  make(map[string]string),
 		files:       make([]FileInfo, 0),
 		errors:      make([]ErrorInfo, 0),
 	}
@@ -159,29 +162,65 @@ func (c *Core) collectRoots(workingRoot string, state *lsContext) {
 
 func (c *Core) scanWorkspaceFiles(ctx context.Context, workingRoot string, state *lsContext) {
 	for _, inputFilesDir := range c.inputs.InputFilesDir {
-		root := inputFilesDir.Root
-		if !filepath.IsAbs(root) {
-			root = filepath.Join(workingRoot, root)
+		c.scanWorkspaceDir(ctx, workingRoot, inputFilesDir, state)
+	}
+}
+
+func (c *Core) scanWorkspaceDir(
+	ctx context.Context, workingRoot string, inputFilesDir InputFilesDir, state *lsContext,
+) {
+	root := inputFilesDir.Root
+	if !filepath.IsAbs(root) {
+		root = filepath.Join(workingRoot, root)
+	}
+	root = normalizeAbsPath(root)
+
+	walker := fs.NewFSWalker(root, inputFilesDir.Path)
+	walker.WalkDir(func(path string, err error) error {
+		if err != nil {
+			state.errors = append(state.errors, ErrorInfo{
+				Code:    "workspace_walk_error",
+				Message: err.Error(),
+			})
+			return nil
 		}
-		root = normalizeAbsPath(root)
-
-		walker := fs.NewFSWalker(root, inputFilesDir.Path)
-		walker.WalkDir(func(path string, err error) error {
-			if err != nil {
-				state.errors = append(state.errors, ErrorInfo{
-					Code:    "workspace_walk_error",
-					Message: err.Error(),
-				})
-				return nil
-			}
-			if ctx.Err() != nil || filepath.Ext(path) != ".proto" {
-				return nil
-			}
-			absPath := filepath.Join(root, path)
-			state.addFile(absPath, path, FileSourceWorkspace, root)
+		if ctx.Err() != nil || filepath.Ext(path) != ".proto" {
 			return nil
-		})
+		}
+		absPath := filepath.Join(root, path)
+		if state.isOutsideWorkspace(absPath) {
+			return nil
+		}
+		state.addFile(absPath, path, FileSourceWorkspace, root)
+		return nil
+	})
+}
+
+// workspaceImports collects workspace files with their transitive imports like ListFiles does.
+// If generate inputs are not configured the whole working root is the workspace,
+// except dependencies and vendor dir placed inside it.
+func (c *Core) workspaceImports(ctx context.Context, workingRoot string) *lsContext {
+	state := newLsContext()
+	c.collectRoots(workingRoot, state)
+
+	if len(c.inputs.InputFilesDir) > 0 {
+		c.scanWorkspaceFiles(ctx, workingRoot, state)
+	} else {
+		vendorDir := c.vendorDir
+		if vendorDir != "" && !filepath.IsAbs(vendorDir) {
+			vendorDir = filepath.Join(workingRoot, vendorDir)
+		}
+		if vendorDir != "" {
+			state.excluded = append(state.excluded, normalizeAbsPath(vendorDir))
+		}
+
+		state.addRoot(workingRoot, FileSourceWorkspace)
+		c.scanWorkspaceDir(ctx, workingRoot, InputFilesDir{Path: ".", Root: "."}, state)
 	}
+
+	c.collectImports(ctx, state)
+
+	return state
 }
 
 func (c *Core) collectImports(ctx context.Context, state *lsContext) {
@@ -226,6 +265,7 @@ func (c *Core) processImports(
 		if abs, src, root, ok := state.resolveImport(currentAbs, impPath); ok {
 			if _, seen := visited[abs]; !seen {
 				visited[abs] = struct{}{}
+				state.importedBy[abs] = currentAbs
 				*queue = append(*queue, abs)
 				state.addFile(abs, impPath, src, root)
 			}
@@ -367,6 +407,26 @@ func (s *lsContext) sortedFiles() []FileInfo {
 	return files
 }
 
+// isOutsideWorkspace check if the file belongs to dependency or excluded dir.
+func (s *lsContext) isOutsideWorkspace(absPath string) bool {
+	absPath = normalizeAbsPath(absPath)
+	for _, r := range s.searchRoots {
+		if r.Source != FileSourceWorkspace && isSubPath(r.Path, absPath) {
+			return true
+		}
+	}
+	for _, dir := range s.excluded {
+		if isSubPath(dir, absPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSubPath(dir, path string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, "/")+"/")
+}
+
 func (s *lsContext) sourceForAbs(absPath string) FileSource {
 	absPath = normalizeAbsPath(absPath)
 	root := s.fileRoot[absPath]
@@ -80,6 +80,62 @@ func (_c *CurrentProjectGitWalker_GetDirWalker_Call) RunAndReturn(run func(strin
 	return _c
 }
 
+// LastVersionTag provides a mock function with given fields: workingDir
+func (_m *CurrentProjectGitWalker) LastVersionTag(workingDir string) (string, error) {
+	ret := _m.Called(workingDir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastVersionTag")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(workingDir)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(workingDir)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workingDir)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CurrentProjectGitWalker_LastVersionTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastVersionTag'
+type CurrentProjectGitWalker_LastVersionTag_Call struct {
+	*mock.Call
+}
+
+// LastVersionTag is a helper method to define mock.On call
+//   - workingDir string
+func (_e *CurrentProjectGitWalker_Expecter) LastVersionTag(workingDir interface{}) *CurrentProjectGitWalker_LastVersionTag_Call {
+	return &CurrentProjectGitWalker_LastVersionTag_Call{Call: _e.mock.On("LastVersionTag", workingDir)}
+}
+
+func (_c *CurrentProjectGitWalker_LastVersionTag_Call) Run(run func(workingDir string)) *CurrentProjectGitWalker_LastVersionTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CurrentProjectGitWalker_LastVersionTag_Call) Return(_a0 string, _a1 error) *CurrentProjectGitWalker_LastVersionTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CurrentProjectGitWalker_LastVersionTag_Call) RunAndReturn(run func(string) (string, error)) *CurrentProjectGitWalker_LastVersionTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCurrentProjectGitWalker creates a new instance of CurrentProjectGitWalker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCurrentProjectGitWalker(t interface {
@@ -170,17 +170,63 @@ func (_c *LockFile_Read_Call) RunAndReturn(run func(string) (models.LockFileInfo
 	return _c
 }
 
-// Write provides a mock function with given fields: moduleName, revisionVersion, installedPackageHash
-func (_m *LockFile) Write(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash) error {
-	ret := _m.Called(moduleName, revisionVersion, installedPackageHash)
+// Remove provides a mock function with given fields: moduleName
+func (_m *LockFile) Remove(moduleName string) error {
+	ret := _m.Called(moduleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(moduleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockFile_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type LockFile_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - moduleName string
+func (_e *LockFile_Expecter) Remove(moduleName interface{}) *LockFile_Remove_Call {
+	return &LockFile_Remove_Call{Call: _e.mock.On("Remove", moduleName)}
+}
+
+func (_c *LockFile_Remove_Call) Run(run func(moduleName string)) *LockFile_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *LockFile_Remove_Call) Return(_a0 error) *LockFile_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LockFile_Remove_Call) RunAndReturn(run func(string) error) *LockFile_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: moduleName, revisionVersion, installedPackageHash, indirect
+func (_m *LockFile) Write(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool) error {
+	ret := _m.Called(moduleName, revisionVersion, installedPackageHash, indirect)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Write")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, models.ModuleHash) error); ok {
-		r0 = rf(moduleName, revisionVersion, installedPackageHash)
+	if rf, ok := ret.Get(0).(func(string, string, models.ModuleHash, bool) error); ok {
+		r0 = rf(moduleName, revisionVersion, installedPackageHash, indirect)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -197,13 +243,14 @@ type LockFile_Write_Call struct {
 //   - moduleName string
 //   - revisionVersion string
 //   - installedPackageHash models.ModuleHash
-func (_e *LockFile_Expecter) Write(moduleName interface{}, revisionVersion interface{}, installedPackageHash interface{}) *LockFile_Write_Call {
-	return &LockFile_Write_Call{Call: _e.mock.On("Write", moduleName, revisionVersion, installedPackageHash)}
+//   - indirect bool
+func (_e *LockFile_Expecter) Write(moduleName interface{}, revisionVersion interface{}, installedPackageHash interface{}, indirect interface{}) *LockFile_Write_Call {
+	return &LockFile_Write_Call{Call: _e.mock.On("Write", moduleName, revisionVersion, installedPackageHash, indirect)}
 }
 
-func (_c *LockFile_Write_Call) Run(run func(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash)) *LockFile_Write_Call {
+func (_c *LockFile_Write_Call) Run(run func(moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool)) *LockFile_Write_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(models.ModuleHash))
+		run(args[0].(string), args[1].(string), args[2].(models.ModuleHash), args[3].(bool))
 	})
 	return _c
 }
@@ -213,7 +260,7 @@ func (_c *LockFile_Write_Call) Return(_a0 error) *LockFile_Write_Call {
 	return _c
 }
 
-func (_c *LockFile_Write_Call) RunAndReturn(run func(string, string, models.ModuleHash) error) *LockFile_Write_Call {
+func (_c *LockFile_Write_Call) RunAndReturn(run func(string, string, models.ModuleHash, bool) error) *LockFile_Write_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -45,8 +45,9 @@ type (
 	LockFile interface {
 		Read(moduleName string) (models.LockFileInfo, error)
 		Write(
-			moduleName string, revisionVersion string, installedPackageHash models.ModuleHash,
+			moduleName string, revisionVersion string, installedPackageHash models.ModuleHash, indirect bool,
 		) error
+		Remove(moduleName string) error
 		IsEmpty() bool
 		DepsIter() iter.Seq[models.LockFileInfo]
 	}
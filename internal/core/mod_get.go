@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+// latestVersion is a requested version which means the latest commit of the default branch.
+const latestVersion models.RequestedVersion = "latest"
+
+var (
+	ErrGetCanceled      = errors.New("get canceled")
+	ErrModuleNotInDeps  = errors.New("module is not in deps")
+	ErrModuleNameMissed = errors.New("module name is empty")
+)
+
+// GetOptions contains options for the Get command.
+type GetOptions struct {
+	// Prompter confirms versions resolved from latest or a branch, confirmation is skipped if it's nil.
+	Prompter Prompter
+}
+
+// Get adds modules to deps or changes their versions.
+// Requested versions are resolved to revisions, modules are installed with their dependencies
+// and lock file is rewritten. Returned modules contain resolved versions which have to be written to config.
+func (c *Core) Get(ctx context.Context, modules []models.Module, opts GetOptions) ([]models.Module, error) {
+	pinned, err := pinModules(ctx, modules, c.loadRequirements, opts.Prompter)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, _, err := c.lockedRoots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("c.lockedRoots: %w", err)
+	}
+
+	roots = slices.DeleteFunc(roots, func(root models.Module) bool {
+		return slices.ContainsFunc(pinned, func(module models.Module) bool { return module.Name == root.Name })
+	})
+
+	if err := c.installResolved(ctx, append(roots, pinned...)); err != nil {
+		return nil, fmt.Errorf("c.installResolved: %w", err)
+	}
+
+	for _, module := range pinned {
+		c.logger.Info(ctx, "module is added to deps",
+			slog.String("module", module.Name), slog.String("version", string(module.Version)))
+	}
+
+	return pinned, nil
+}
+
+// Remove removes modules from deps: lock file is rewritten without them
+// and without their dependencies which are not required by other deps.
+func (c *Core) Remove(ctx context.Context, moduleNames []string) error {
+	roots, _, err := c.lockedRoots(ctx)
+	if err != nil {
+		return fmt.Errorf("c.lockedRoots: %w", err)
+	}
+
+	for _, moduleName := range moduleNames {
+		if !slices.ContainsFunc(roots, func(root models.Module) bool { return root.Name == moduleName }) {
+			return fmt.Errorf("%s: %w", moduleName, ErrModuleNotInDeps)
+		}
+	}
+
+	roots = slices.DeleteFunc(roots, func(root models.Module) bool {
+		return slices.Contains(moduleNames, root.Name)
+	})
+
+	if err := c.installResolved(ctx, roots); err != nil {
+		return fmt.Errorf("c.installResolved: %w", err)
+	}
+
+	return nil
+}
+
+// pinModules resolves requested versions to revision versions.
+// Versions which don't match the revision (latest, branches, commits) are confirmed by prompter,
+// so config doesn't get a floating version.
+func pinModules(
+	ctx context.Context, modules []models.Module, load requirementsLoader, prompter Prompter,
+) ([]models.Module, error) {
+	pinned := make([]models.Module, 0, len(modules))
+
+	for _, module := range modules {
+		if module.Name == "" {
+			return nil, ErrModuleNameMissed
+		}
+
+		requested := module
+		if requested.Version == latestVersion {
+			requested.Version = models.Omitted
+		}
+
+		revision, _, err := load(ctx, requested)
+		if err != nil {
+			return nil, fmt.Errorf("load: %s: %w", module.Name, err)
+		}
+
+		if revision.Version != string(requested.Version) && prompter != nil {
+			version := module.Version
+			if version.IsOmitted() {
+				version = latestVersion
+			}
+
+			message := fmt.Sprintf("%s@%s resolves to %s. Pin this version?", module.Name, version, revision.Version)
+			confirmed, err := prompter.Confirm(ctx, message, true)
+			if err != nil {
+				return nil, fmt.Errorf("prompter.Confirm: %w", err)
+			}
+			if !confirmed {
+				return nil, ErrGetCanceled
+			}
+		}
+
+		pinned = append(pinned, models.Module{Name: module.Name, Version: models.RequestedVersion(revision.Version)})
+	}
+
+	return pinned, nil
+}
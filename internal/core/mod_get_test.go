@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+// fakePrompter answers every confirmation the same way and records questions.
+type fakePrompter struct {
+	answer   bool
+	messages []string
+}
+
+func (p *fakePrompter) Confirm(_ context.Context, message string, _ bool) (bool, error) {
+	p.messages = append(p.messages, message)
+	return p.answer, nil
+}
+
+func TestPinModules(t *testing.T) {
+	t.Parallel()
+
+	registry := map[string]fakeModuleVersion{
+		"github.com/googleapis/googleapis@v1.1.0": {
+			revision: models.Revision{CommitHash: "g1", Version: "v1.1.0"},
+		},
+		"github.com/googleapis/googleapis@main": {
+			revision: models.Revision{CommitHash: "g2", Version: "v0.0.0-20250101000000-g2"},
+		},
+		"github.com/googleapis/googleapis@": {
+			revision: models.Revision{CommitHash: "g2", Version: "v0.0.0-20250101000000-g2"},
+		},
+	}
+
+	tests := map[string]struct {
+		modules      []string
+		answer       bool
+		noPrompter   bool
+		want         []models.Module
+		wantMessages []string
+		wantErr      error
+	}{
+		"tag is pinned without confirmation": {
+			modules: []string{"github.com/googleapis/googleapis@v1.1.0"},
+			want:    []models.Module{{Name: "github.com/googleapis/googleapis", Version: "v1.1.0"}},
+		},
+		"branch is confirmed": {
+			modules: []string{"github.com/googleapis/googleapis@main"},
+			answer:  true,
+			want:    []models.Module{{Name: "github.com/googleapis/googleapis", Version: "v0.0.0-20250101000000-g2"}},
+			wantMessages: []string{
+				"github.com/googleapis/googleapis@main resolves to v0.0.0-20250101000000-g2. Pin this version?",
+			},
+		},
+		"latest is confirmed": {
+			modules: []string{"github.com/googleapis/googleapis@latest"},
+			answer:  true,
+			want:    []models.Module{{Name: "github.com/googleapis/googleapis", Version: "v0.0.0-20250101000000-g2"}},
+			wantMessages: []string{
+				"github.com/googleapis/googleapis@latest resolves to v0.0.0-20250101000000-g2. Pin this version?",
+			},
+		},
+		"omitted version is latest": {
+			modules: []string{"github.com/googleapis/googleapis"},
+			answer:  true,
+			want:    []models.Module{{Name: "github.com/googleapis/googleapis", Version: "v0.0.0-20250101000000-g2"}},
+			wantMessages: []string{
+				"github.com/googleapis/googleapis@latest resolves to v0.0.0-20250101000000-g2. Pin this version?",
+			},
+		},
+		"declined branch": {
+			modules: []string{"github.com/googleapis/googleapis@main"},
+			wantMessages: []string{
+				"github.com/googleapis/googleapis@main resolves to v0.0.0-20250101000000-g2. Pin this version?",
+			},
+			wantErr: ErrGetCanceled,
+		},
+		"confirmation is skipped without prompter": {
+			modules:    []string{"github.com/googleapis/googleapis@main"},
+			noPrompter: true,
+			want:       []models.Module{{Name: "github.com/googleapis/googleapis", Version: "v0.0.0-20250101000000-g2"}},
+		},
+		"unknown version": {
+			modules: []string{"github.com/googleapis/googleapis@v9.9.9"},
+			wantErr: models.ErrVersionNotFound,
+		},
+		"empty module name": {
+			modules: []string{"@v1.1.0"},
+			wantErr: ErrModuleNameMissed,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			modules := make([]models.Module, 0, len(tc.modules))
+			for _, module := range tc.modules {
+				modules = append(modules, models.NewModule(module))
+			}
+
+			p := &fakePrompter{answer: tc.answer}
+			var prompter Prompter = p
+			if tc.noPrompter {
+				prompter = nil
+			}
+
+			got, err := pinModules(context.Background(), modules, fakeLoader(registry), prompter)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantMessages, p.messages)
+		})
+	}
+}
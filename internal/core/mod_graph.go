@@ -0,0 +1,115 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+type (
+	// ModuleGraphNode is a module version visited while building the graph.
+	ModuleGraphNode struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+		// Indirect is set if module is not listed in easyp.yaml.
+		Indirect bool `json:"indirect"`
+	}
+
+	// ModuleGraphEdge is a requirement of one module version by another one.
+	ModuleGraphEdge struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+		// Indirect is set if requirement is declared by a dependency, not by easyp.yaml.
+		Indirect bool `json:"indirect"`
+	}
+
+	// ModuleGraph contains every module version reachable from easyp.yaml and requirements between them.
+	// Like go mod graph it shows all required versions, not only selected ones.
+	ModuleGraph struct {
+		Root  string            `json:"root"`
+		Nodes []ModuleGraphNode `json:"nodes"`
+		Edges []ModuleGraphEdge `json:"edges"`
+	}
+)
+
+// Graph builds dependency graph from deps in config.
+// Versions of deps are taken from lock file if they are locked.
+func (c *Core) Graph(ctx context.Context) (ModuleGraph, error) {
+	roots, _, err := c.lockedRoots(ctx)
+	if err != nil {
+		return ModuleGraph{}, fmt.Errorf("c.lockedRoots: %w", err)
+	}
+
+	graph, err := buildDependencyGraph(ctx, roots, c.loadRequirements)
+	if err != nil {
+		return ModuleGraph{}, fmt.Errorf("buildDependencyGraph: %w", err)
+	}
+
+	return newModuleGraph(graph), nil
+}
+
+func newModuleGraph(graph dependencyGraph) ModuleGraph {
+	res := ModuleGraph{
+		Root:  rootRequirement,
+		Nodes: make([]ModuleGraphNode, 0, len(graph.names)),
+		Edges: make([]ModuleGraphEdge, 0),
+	}
+
+	seenNodes := make(map[ModuleGraphNode]struct{})
+	seenEdges := make(map[ModuleGraphEdge]struct{})
+
+	for _, name := range graph.names {
+		for _, req := range graph.requirements[name] {
+			node := ModuleGraphNode{Name: name, Version: req.Revision.Version, Indirect: !graph.direct[name]}
+			if _, ok := seenNodes[node]; !ok {
+				seenNodes[node] = struct{}{}
+				res.Nodes = append(res.Nodes, node)
+			}
+
+			edge := ModuleGraphEdge{From: rootRequirement, To: node.String()}
+			if len(req.Path) > 0 {
+				edge.From = req.Path[len(req.Path)-1]
+				edge.Indirect = true
+			}
+			if _, ok := seenEdges[edge]; !ok {
+				seenEdges[edge] = struct{}{}
+				res.Edges = append(res.Edges, edge)
+			}
+		}
+	}
+
+	// generated versions aren't semver, so versions are also compared as strings to keep output stable
+	slices.SortFunc(res.Nodes, func(a, b ModuleGraphNode) int {
+		return cmp.Or(
+			strings.Compare(a.Name, b.Name),
+			semver.Compare(CanonicalVersion(a.Version), CanonicalVersion(b.Version)),
+			strings.Compare(a.Version, b.Version),
+		)
+	})
+
+	slices.SortFunc(res.Edges, func(a, b ModuleGraphEdge) int {
+		// direct edges first
+		if a.Indirect != b.Indirect {
+			if a.Indirect {
+				return 1
+			}
+			return -1
+		}
+
+		return cmp.Or(
+			strings.Compare(a.From, b.From),
+			strings.Compare(a.To, b.To),
+		)
+	})
+
+	return res
+}
+
+// String returns module version as name@version.
+func (n ModuleGraphNode) String() string {
+	return n.Name + "@" + n.Version
+}
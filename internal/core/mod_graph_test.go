@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+func TestNewModuleGraph(t *testing.T) {
+	t.Parallel()
+
+	registry := map[string]fakeModuleVersion{
+		"github.com/acme/api@v1.0.0": {
+			revision: models.Revision{CommitHash: "a1", Version: "v1.0.0"},
+			requires: []string{"github.com/googleapis/googleapis@v1.1.0", "github.com/acme/types@v0.1.0"},
+		},
+		"github.com/acme/types@v0.1.0": {
+			revision: models.Revision{CommitHash: "t1", Version: "v0.1.0"},
+			requires: []string{"github.com/googleapis/googleapis@v1.3.0"},
+		},
+		"github.com/googleapis/googleapis@v1.1.0": {
+			revision: models.Revision{CommitHash: "g1", Version: "v1.1.0"},
+		},
+		"github.com/googleapis/googleapis@v1.3.0": {
+			revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+		},
+		"github.com/googleapis/googleapis@": {
+			revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+		},
+	}
+
+	roots := []models.Module{
+		models.NewModule("github.com/acme/api@v1.0.0"),
+		models.NewModule("github.com/googleapis/googleapis"),
+	}
+
+	graph, err := buildDependencyGraph(context.Background(), roots, fakeLoader(registry))
+	require.NoError(t, err)
+
+	want := ModuleGraph{
+		Root: "easyp.yaml",
+		Nodes: []ModuleGraphNode{
+			{Name: "github.com/acme/api", Version: "v1.0.0"},
+			{Name: "github.com/acme/types", Version: "v0.1.0", Indirect: true},
+			{Name: "github.com/googleapis/googleapis", Version: "v1.1.0"},
+			{Name: "github.com/googleapis/googleapis", Version: "v1.3.0"},
+		},
+		Edges: []ModuleGraphEdge{
+			{From: "easyp.yaml", To: "github.com/acme/api@v1.0.0"},
+			{From: "easyp.yaml", To: "github.com/googleapis/googleapis@v1.3.0"},
+			{From: "github.com/acme/api@v1.0.0", To: "github.com/acme/types@v0.1.0", Indirect: true},
+			{From: "github.com/acme/api@v1.0.0", To: "github.com/googleapis/googleapis@v1.1.0", Indirect: true},
+			{From: "github.com/acme/types@v0.1.0", To: "github.com/googleapis/googleapis@v1.3.0", Indirect: true},
+		},
+	}
+
+	require.Equal(t, want, newModuleGraph(graph))
+}
+
+func TestNewModuleGraph_NonSemverVersions(t *testing.T) {
+	t.Parallel()
+
+	const googleapis = "github.com/googleapis/googleapis"
+
+	versions := []string{"common-protos-1_9_0", "v1.2.0", "common-protos-1_3_1", "814bf88cf225", "1.1.0"}
+
+	requirements := make([]DependencyRequirement, 0, len(versions))
+	for i, version := range versions {
+		requirements = append(requirements, DependencyRequirement{
+			Path:     []string{fmt.Sprintf("github.com/acme/api%d@v1.0.0", i)},
+			Revision: models.Revision{Version: version},
+		})
+	}
+
+	graph := dependencyGraph{
+		names:        []string{googleapis},
+		requirements: map[string][]DependencyRequirement{googleapis: requirements},
+		direct:       map[string]bool{googleapis: true},
+	}
+
+	// versions which aren't semver are equal for semver, they are ordered as strings
+	want := []ModuleGraphNode{
+		{Name: googleapis, Version: "814bf88cf225"},
+		{Name: googleapis, Version: "common-protos-1_3_1"},
+		{Name: googleapis, Version: "common-protos-1_9_0"},
+		{Name: googleapis, Version: "1.1.0"},
+		{Name: googleapis, Version: "v1.2.0"},
+	}
+
+	require.Equal(t, want, newModuleGraph(graph).Nodes)
+}
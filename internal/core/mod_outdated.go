@@ -0,0 +1,252 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/easyp-tech/easyp/internal/adapters/repository/git"
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+)
+
+// UpdateKind is a kind of version change by semver.
+type UpdateKind string
+
+const (
+	UpdatePatch UpdateKind = "patch"
+	UpdateMinor UpdateKind = "minor"
+	UpdateMajor UpdateKind = "major"
+)
+
+type (
+	// OutdatedOptions contains options for the Outdated command.
+	OutdatedOptions struct {
+		// Breaking enables breaking check of the latest version against the locked one.
+		Breaking bool
+	}
+
+	// ModuleUpdate is a release tag newer than the locked version.
+	ModuleUpdate struct {
+		Version string     `json:"version"`
+		Kind    UpdateKind `json:"kind"`
+	}
+
+	// OutdatedBreaking contains breaking changes of the version against the locked one.
+	OutdatedBreaking struct {
+		Version string      `json:"version"`
+		Issues  []IssueInfo `json:"issues"`
+	}
+
+	// OutdatedModule is a module from lock file with newer release tags.
+	OutdatedModule struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+		// Indirect is set if module is not listed in easyp.yaml.
+		Indirect bool `json:"indirect"`
+		// Updates are sorted from the lowest version.
+		Updates []ModuleUpdate `json:"updates"`
+		// Breaking is set if breaking check was requested and there are updates.
+		Breaking *OutdatedBreaking `json:"breaking,omitempty"`
+	}
+)
+
+// Outdated returns modules from lock file which have release tags newer than locked versions.
+func (c *Core) Outdated(ctx context.Context, opts OutdatedOptions) ([]OutdatedModule, error) {
+	if err := c.Download(ctx); err != nil {
+		return nil, fmt.Errorf("c.Download: %w", err)
+	}
+
+	lockFileInfos := slices.SortedFunc(c.lockFile.DepsIter(), func(a, b models.LockFileInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	res := make([]OutdatedModule, 0, len(lockFileInfos))
+
+	for _, lockFileInfo := range lockFileInfos {
+		tags, err := c.listTags(ctx, lockFileInfo.Name)
+		if err != nil {
+			return nil, fmt.Errorf("c.listTags: %s: %w", lockFileInfo.Name, err)
+		}
+
+		updates := newerVersions(lockFileInfo.Version, tags)
+		if len(updates) == 0 {
+			c.logger.Debug(ctx, "module is up to date", slog.String("module", lockFileInfo.Name))
+			continue
+		}
+
+		module := OutdatedModule{
+			Name:     lockFileInfo.Name,
+			Version:  lockFileInfo.Version,
+			Indirect: lockFileInfo.Indirect,
+			Updates:  updates,
+		}
+
+		if opts.Breaking {
+			latest := updates[len(updates)-1].Version
+
+			issues, err := c.moduleBreakingCheck(ctx, lockFileInfo, latest)
+			if err != nil {
+				return nil, fmt.Errorf("c.moduleBreakingCheck: %s@%s: %w", lockFileInfo.Name, latest, err)
+			}
+
+			module.Breaking = &OutdatedBreaking{Version: latest, Issues: issues}
+		}
+
+		res = append(res, module)
+	}
+
+	return res, nil
+}
+
+// Latest returns the highest update of the kind, empty string if there is no such update.
+func (m OutdatedModule) Latest(kind UpdateKind) string {
+	for _, update := range slices.Backward(m.Updates) {
+		if update.Kind == kind {
+			return update.Version
+		}
+	}
+
+	return ""
+}
+
+// listTags returns tags of remote repository of the module.
+func (c *Core) listTags(ctx context.Context, moduleName string) ([]string, error) {
+	cacheRepositoryDir, err := c.storage.CreateCacheRepositoryDir(moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("c.storage.CreateCacheRepositoryDir: %w", err)
+	}
+	// TODO: use factory (git, svn etc)
+	repo, err := git.New(ctx, moduleName, cacheRepositoryDir, c.console)
+	if err != nil {
+		return nil, fmt.Errorf("git.New: %w", err)
+	}
+
+	tags, err := repo.Tags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository.Tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// moduleBreakingCheck compares proto files of the module version with the locked one.
+// Lock file is not changed, the version is only installed into storage.
+func (c *Core) moduleBreakingCheck(
+	ctx context.Context, lockFileInfo models.LockFileInfo, version string,
+) ([]IssueInfo, error) {
+	installedModuleInfo, err := c.install(ctx, models.Module{
+		Name:    lockFileInfo.Name,
+		Version: models.RequestedVersion(version),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("c.install: %w", err)
+	}
+
+	currentDir := c.storage.GetInstallDir(lockFileInfo.Name, installedModuleInfo.RevisionVersion)
+	againstDir := c.storage.GetInstallDir(lockFileInfo.Name, lockFileInfo.Version)
+
+	issues, err := c.breakingCheckDirs(ctx, currentDir, againstDir)
+	if err != nil {
+		return nil, fmt.Errorf("c.breakingCheckDirs: %w", err)
+	}
+
+	return issues, nil
+}
+
+// breakingCheckDirs compares proto files of two directories,
+// rules and ignore_only of breaking check config are applied like in breaking command.
+func (c *Core) breakingCheckDirs(ctx context.Context, currentDir, againstDir string) ([]IssueInfo, error) {
+	currentProtoFiles, err := c.readProtoFiles(ctx, fs.NewFSWalker(currentDir, "."))
+	if err != nil {
+		return nil, fmt.Errorf("c.readCurrentProtoFiles: %w", err)
+	}
+
+	againstProtoFiles, err := c.readProtoFiles(ctx, fs.NewFSWalker(againstDir, "."))
+	if err != nil {
+		return nil, fmt.Errorf("c.readAgainstProtoFiles: %w", err)
+	}
+
+	currentProtoData, err := collect(currentProtoFiles)
+	if err != nil {
+		return nil, fmt.Errorf("collect(current): %w", err)
+	}
+
+	againstProtoData, err := collect(againstProtoFiles)
+	if err != nil {
+		return nil, fmt.Errorf("collect(against): %w", err)
+	}
+
+	breakingChecker := &BreakingChecker{
+		against:                againstProtoData,
+		current:                currentProtoData,
+		ignoreUnstablePackages: c.breakingCheckConfig.IgnoreUnstablePackages,
+	}
+
+	issues, err := breakingChecker.Check()
+	if err != nil {
+		return nil, fmt.Errorf("breakingChecker.Check: %w", err)
+	}
+
+	return c.filterBreakingIssues(issues), nil
+}
+
+// newerVersions returns release tags greater than current version by semver.
+// Prerelease tags and tags which are not semver are skipped, "v" prefix of tags is optional.
+// Like in go mod, generated version is older than any release tag.
+func newerVersions(current string, tags []string) []ModuleUpdate {
+	current = CanonicalVersion(current)
+
+	versions := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		version := CanonicalVersion(tag)
+		if !semver.IsValid(version) || semver.Prerelease(version) != "" || semver.Compare(version, current) <= 0 {
+			continue
+		}
+
+		versions = append(versions, tag)
+	}
+
+	// tags are returned as is, so they can be installed,
+	// canonical tag is preferred among tags of the same version (e.g. v1.2.0 to 1.2.0 and v1.2.0+build)
+	rank := func(tag string) int {
+		if semver.Canonical(tag) == tag {
+			return 0
+		}
+		return 1
+	}
+	slices.SortFunc(versions, func(a, b string) int {
+		return cmp.Or(
+			semver.Compare(CanonicalVersion(a), CanonicalVersion(b)),
+			cmp.Compare(rank(a), rank(b)),
+			strings.Compare(a, b),
+		)
+	})
+	versions = slices.CompactFunc(versions, func(a, b string) bool {
+		return semver.Compare(CanonicalVersion(a), CanonicalVersion(b)) == 0
+	})
+
+	updates := make([]ModuleUpdate, 0, len(versions))
+	for _, version := range versions {
+		updates = append(updates, ModuleUpdate{Version: version, Kind: updateKind(current, CanonicalVersion(version))})
+	}
+
+	return updates
+}
+
+// updateKind classifies change from current version to the newer one.
+func updateKind(current, newer string) UpdateKind {
+	switch {
+	case semver.Major(current) != semver.Major(newer):
+		return UpdateMajor
+	case semver.MajorMinor(current) != semver.MajorMinor(newer):
+		return UpdateMinor
+	default:
+		return UpdatePatch
+	}
+}
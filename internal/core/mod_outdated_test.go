@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+func TestNewerVersions(t *testing.T) {
+	t.Parallel()
+
+	tags := []string{
+		"v1.2.2", "v1.2.3", "v1.2.4", "v1.3.0", "v1.3.1", "v2.0.0",
+		"v1.4.0-rc.1", "release-1", "1.5.0", "v1.2.4+build",
+	}
+
+	tests := map[string]struct {
+		current string
+		tags    []string
+		want    []ModuleUpdate
+	}{
+		"patch, minor and major updates": {
+			current: "v1.2.3",
+			tags:    tags,
+			want: []ModuleUpdate{
+				{Version: "v1.2.4", Kind: UpdatePatch},
+				{Version: "v1.3.0", Kind: UpdateMinor},
+				{Version: "v1.3.1", Kind: UpdateMinor},
+				{Version: "1.5.0", Kind: UpdateMinor},
+				{Version: "v2.0.0", Kind: UpdateMajor},
+			},
+		},
+		"tags without v prefix": {
+			current: "1.2.3",
+			tags:    []string{"1.2.4", "1.3.0", "v1.3.0", "2.0.0-rc.1", "2.0.0"},
+			want: []ModuleUpdate{
+				{Version: "1.2.4", Kind: UpdatePatch},
+				{Version: "v1.3.0", Kind: UpdateMinor},
+				{Version: "2.0.0", Kind: UpdateMajor},
+			},
+		},
+		"up to date": {
+			current: "v2.0.0",
+			tags:    tags,
+			want:    []ModuleUpdate{},
+		},
+		"generated version is older than release tags": {
+			current: "v0.0.0-20250101000000-abc",
+			tags:    []string{"v0.1.0", "v1.0.0"},
+			want: []ModuleUpdate{
+				{Version: "v0.1.0", Kind: UpdateMinor},
+				{Version: "v1.0.0", Kind: UpdateMajor},
+			},
+		},
+		"no tags": {
+			current: "v1.0.0",
+			want:    []ModuleUpdate{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, newerVersions(tc.current, tc.tags))
+		})
+	}
+}
+
+func TestOutdatedModule_Latest(t *testing.T) {
+	t.Parallel()
+
+	module := OutdatedModule{
+		Name:    "github.com/googleapis/googleapis",
+		Version: "v1.2.3",
+		Updates: newerVersions("v1.2.3", []string{"v1.2.4", "v1.2.5", "v1.3.0"}),
+	}
+
+	require.Equal(t, "v1.2.5", module.Latest(UpdatePatch))
+	require.Equal(t, "v1.3.0", module.Latest(UpdateMinor))
+	require.Empty(t, module.Latest(UpdateMajor))
+}
+
+func TestCore_breakingCheckDirs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		originalChangesDir = "../../testdata/breaking_check/changes/original"
+		currentChangesDir  = "../../testdata/breaking_check/changes/current"
+	)
+
+	tests := map[string]struct {
+		config    BreakingCheckConfig
+		wantRules []string
+	}{
+		"disabled rules are filtered": {
+			config:    BreakingCheckConfig{Rules: []string{ruleFieldSameLabel}},
+			wantRules: []string{ruleFieldSameLabel, ruleFieldSameLabel, ruleFieldSameLabel},
+		},
+		"ignore only": {
+			config: BreakingCheckConfig{
+				Rules:      []string{ruleFieldSameLabel},
+				IgnoreOnly: map[string][]string{ruleFieldSameLabel: {"changes.proto"}},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Core{logger: logger.NewNop(), breakingCheckConfig: tc.config}
+
+			issues, err := c.breakingCheckDirs(context.Background(), currentChangesDir, originalChangesDir)
+			require.NoError(t, err)
+
+			var gotRules []string
+			for _, issue := range issues {
+				gotRules = append(gotRules, issue.RuleName)
+			}
+			require.Equal(t, tc.wantRules, gotRules)
+		})
+	}
+}
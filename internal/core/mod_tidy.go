@@ -0,0 +1,197 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+type (
+	// TransitiveImport is an import of workspace file satisfied only by indirect dependency.
+	TransitiveImport struct {
+		File   string `json:"file"`
+		Import string `json:"import"`
+		Module string `json:"module"`
+	}
+
+	// TidyResult contains changes made by Tidy, deps in config have to be changed the same way.
+	TidyResult struct {
+		// Removed names of modules which files are not imported by workspace even transitively,
+		// they are removed from deps and from lock file unless other deps still require them.
+		Removed []string `json:"removed"`
+		// Added indirect modules imported by workspace, as name@version.
+		Added []string `json:"added"`
+		// Transitive imports which were satisfied only by indirect modules.
+		Transitive []TransitiveImport `json:"transitive"`
+	}
+)
+
+// Tidy removes modules which are not imported by workspace protos from lock file
+// and marks indirect modules imported by workspace as direct ones.
+// Modules which are still required by remaining deps are kept in lock file as indirect ones,
+// so lock file is not changed back by the next update.
+func (c *Core) Tidy(ctx context.Context, workingRoot string) (TidyResult, error) {
+	if err := c.Download(ctx); err != nil {
+		return TidyResult{}, fmt.Errorf("c.Download: %w", err)
+	}
+
+	return c.tidy(ctx, workingRoot, c.loadRequirements)
+}
+
+func (c *Core) tidy(ctx context.Context, workingRoot string, load requirementsLoader) (TidyResult, error) {
+	lockFileInfos := slices.SortedFunc(c.lockFile.DepsIter(), func(a, b models.LockFileInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	moduleByRoot := make(map[string]models.LockFileInfo, len(lockFileInfos))
+	for _, lockFileInfo := range lockFileInfos {
+		root := normalizeAbsPath(c.storage.GetInstallDir(lockFileInfo.Name, lockFileInfo.Version))
+		moduleByRoot[root] = lockFileInfo
+	}
+
+	// modules of git repo inputs are not listed in deps, so they are kept as is
+	gitRepoModules := make(map[string]struct{}, len(c.inputs.InputGitRepos))
+	for _, repo := range c.inputs.InputGitRepos {
+		gitRepoModules[models.NewModule(repo.URL).Name] = struct{}{}
+	}
+
+	state := c.workspaceImports(ctx, workingRoot)
+
+	// without workspace files every module looks unused
+	if !slices.ContainsFunc(state.files, func(f FileInfo) bool { return f.Source == FileSourceWorkspace }) {
+		return TidyResult{}, ErrEmptyInputFiles
+	}
+
+	importPaths := make(map[string]string, len(state.files))
+	for _, f := range state.files {
+		importPaths[f.AbsPath] = f.ImportPath
+	}
+
+	res := TidyResult{
+		Removed:    make([]string, 0),
+		Added:      make([]string, 0),
+		Transitive: make([]TransitiveImport, 0),
+	}
+
+	used := make(map[string]struct{})
+	promoted := make(map[string]struct{})
+
+	for _, f := range state.files {
+		lockFileInfo, ok := moduleByRoot[f.Root]
+		if !ok {
+			continue
+		}
+
+		used[lockFileInfo.Name] = struct{}{}
+
+		// workspace files are imported before others, so importer is a workspace file
+		// if the file is imported by workspace directly
+		importer, ok := state.importedBy[f.AbsPath]
+		if !ok || !lockFileInfo.Indirect || state.sourceForAbs(importer) != FileSourceWorkspace {
+			continue
+		}
+
+		res.Transitive = append(res.Transitive, TransitiveImport{
+			File:   importPaths[importer],
+			Import: f.ImportPath,
+			Module: lockFileInfo.Name,
+		})
+		promoted[lockFileInfo.Name] = struct{}{}
+
+		c.logger.Warn(ctx, "import is satisfied only by indirect dependency, adding it to deps",
+			slog.String("file", importPaths[importer]),
+			slog.String("import", f.ImportPath),
+			slog.String("module", lockFileInfo.Name),
+		)
+	}
+
+	sort.Slice(res.Transitive, func(i, j int) bool {
+		if res.Transitive[i].File != res.Transitive[j].File {
+			return res.Transitive[i].File < res.Transitive[j].File
+		}
+		return res.Transitive[i].Import < res.Transitive[j].Import
+	})
+
+	// modules required by remaining deps are kept even if workspace doesn't import them
+	required, err := c.requiredModules(ctx, lockFileInfos, gitRepoModules, used, promoted, load)
+	if err != nil {
+		return TidyResult{}, fmt.Errorf("c.requiredModules: %w", err)
+	}
+
+	for _, lockFileInfo := range lockFileInfos {
+		if _, ok := gitRepoModules[lockFileInfo.Name]; ok {
+			continue
+		}
+
+		_, isUsed := used[lockFileInfo.Name]
+		_, isRequired := required[lockFileInfo.Name]
+
+		switch {
+		case !isUsed && !isRequired:
+			c.logger.Info(ctx, "removing unused module", slog.String("module", lockFileInfo.Name))
+
+			if err := c.lockFile.Remove(lockFileInfo.Name); err != nil {
+				return TidyResult{}, fmt.Errorf("c.lockFile.Remove: %w", err)
+			}
+
+			res.Removed = append(res.Removed, lockFileInfo.Name)
+		case !isUsed && !lockFileInfo.Indirect:
+			c.logger.Info(ctx, "removing unused module from deps, it is still required by other deps",
+				slog.String("module", lockFileInfo.Name))
+
+			if err := c.lockFile.Write(lockFileInfo.Name, lockFileInfo.Version, lockFileInfo.Hash, true); err != nil {
+				return TidyResult{}, fmt.Errorf("c.lockFile.Write: %w", err)
+			}
+
+			res.Removed = append(res.Removed, lockFileInfo.Name)
+		}
+
+		if _, ok := promoted[lockFileInfo.Name]; ok {
+			if err := c.lockFile.Write(lockFileInfo.Name, lockFileInfo.Version, lockFileInfo.Hash, false); err != nil {
+				return TidyResult{}, fmt.Errorf("c.lockFile.Write: %w", err)
+			}
+
+			res.Added = append(res.Added, lockFileInfo.Name+"@"+lockFileInfo.Version)
+		}
+	}
+
+	return res, nil
+}
+
+// requiredModules returns names of modules in dependency graph of deps remaining after tidy:
+// used direct modules, promoted indirect modules and modules of git repo inputs with their locked versions.
+func (c *Core) requiredModules(
+	ctx context.Context,
+	lockFileInfos []models.LockFileInfo,
+	gitRepoModules, used, promoted map[string]struct{},
+	load requirementsLoader,
+) (map[string]struct{}, error) {
+	var roots []models.Module
+
+	for _, lockFileInfo := range lockFileInfos {
+		_, isGitRepo := gitRepoModules[lockFileInfo.Name]
+		_, isUsed := used[lockFileInfo.Name]
+		_, isPromoted := promoted[lockFileInfo.Name]
+
+		if isGitRepo || isPromoted || isUsed && !lockFileInfo.Indirect {
+			roots = append(roots, models.NewModuleFromLockFileInfo(lockFileInfo))
+		}
+	}
+
+	graph, err := buildDependencyGraph(ctx, roots, load)
+	if err != nil {
+		return nil, fmt.Errorf("buildDependencyGraph: %w", err)
+	}
+
+	required := make(map[string]struct{}, len(graph.names))
+	for _, name := range graph.names {
+		required[name] = struct{}{}
+	}
+
+	return required, nil
+}
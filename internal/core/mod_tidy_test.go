@@ -0,0 +1,170 @@
+package core
+
+import (
+	"context"
+	"iter"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+func TestCore_tidy(t *testing.T) {
+	t.Parallel()
+
+	installDirs := map[string]string{
+		"github.com/googleapis/googleapis": filepath.Join(modImportsDir, "deps", "googleapis"),
+		"github.com/acme/unused":           filepath.Join(modImportsDir, "deps", "unused"),
+	}
+
+	registry := map[string]fakeModuleVersion{
+		"github.com/googleapis/googleapis@v1.3.0": {
+			revision: models.Revision{CommitHash: "g", Version: "v1.3.0"},
+		},
+		"github.com/googleapis/googleapis@v1.4.0": {
+			revision: models.Revision{CommitHash: "g2", Version: "v1.4.0"},
+			requires: []string{"github.com/acme/unused@v0.1.0"},
+		},
+		"github.com/acme/unused@v0.1.0": {
+			revision: models.Revision{CommitHash: "u", Version: "v0.1.0"},
+		},
+	}
+
+	tests := map[string]struct {
+		lockInfos   []models.LockFileInfo
+		gitRepos    []InputGitRepo
+		workingRoot string
+		want        TidyResult
+		// wantWrites are modules rewritten in lock file
+		wantWrites []models.LockFileInfo
+	}{
+		"remove unused and add transitive": {
+			lockInfos: []models.LockFileInfo{
+				{Name: "github.com/googleapis/googleapis", Version: "v1.3.0", Hash: "h1:g", Indirect: true},
+				{Name: "github.com/acme/unused", Version: "v0.1.0", Hash: "h1:u"},
+			},
+			wantWrites: []models.LockFileInfo{
+				{Name: "github.com/googleapis/googleapis", Version: "v1.3.0", Hash: "h1:g"},
+			},
+			want: TidyResult{
+				Removed: []string{"github.com/acme/unused"},
+				Added:   []string{"github.com/googleapis/googleapis@v1.3.0"},
+				Transitive: []TransitiveImport{
+					{
+						File:   "api/v1/service.proto",
+						Import: "google/api/annotations.proto",
+						Module: "github.com/googleapis/googleapis",
+					},
+				},
+			},
+		},
+		"working root without inputs": {
+			lockInfos: []models.LockFileInfo{
+				{Name: "github.com/googleapis/googleapis", Version: "v1.3.0", Hash: "h1:g"},
+				{Name: "github.com/acme/unused", Version: "v0.1.0", Hash: "h1:u"},
+			},
+			workingRoot: modImportsDir,
+			want: TidyResult{
+				Removed:    []string{"github.com/acme/unused"},
+				Added:      []string{},
+				Transitive: []TransitiveImport{},
+			},
+		},
+		"indirect module required by used module is kept": {
+			lockInfos: []models.LockFileInfo{
+				{Name: "github.com/googleapis/googleapis", Version: "v1.4.0", Hash: "h1:g2"},
+				{Name: "github.com/acme/unused", Version: "v0.1.0", Hash: "h1:u", Indirect: true},
+			},
+			want: TidyResult{
+				Removed:    []string{},
+				Added:      []string{},
+				Transitive: []TransitiveImport{},
+			},
+		},
+		"unused direct module required by used module becomes indirect": {
+			lockInfos: []models.LockFileInfo{
+				{Name: "github.com/googleapis/googleapis", Version: "v1.4.0", Hash: "h1:g2"},
+				{Name: "github.com/acme/unused", Version: "v0.1.0", Hash: "h1:u"},
+			},
+			wantWrites: []models.LockFileInfo{
+				{Name: "github.com/acme/unused", Version: "v0.1.0", Hash: "h1:u", Indirect: true},
+			},
+			want: TidyResult{
+				Removed:    []string{"github.com/acme/unused"},
+				Added:      []string{},
+				Transitive: []TransitiveImport{},
+			},
+		},
+		"git repo input is kept": {
+			lockInfos: []models.LockFileInfo{
+				{Name: "github.com/googleapis/googleapis", Version: "v1.3.0", Hash: "h1:g"},
+				{Name: "github.com/acme/unused", Version: "v0.1.0", Hash: "h1:u"},
+			},
+			gitRepos: []InputGitRepo{{URL: "github.com/acme/unused@v0.1.0"}},
+			want: TidyResult{
+				Removed:    []string{},
+				Added:      []string{},
+				Transitive: []TransitiveImport{},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lockFile := NewLockFileMock(t)
+			storage := NewStorageMock(t)
+
+			var depsIter iter.Seq[models.LockFileInfo] = func(yield func(models.LockFileInfo) bool) {
+				for _, info := range tc.lockInfos {
+					if !yield(info) {
+						return
+					}
+				}
+			}
+			lockFile.EXPECT().DepsIter().Return(depsIter)
+			for _, info := range tc.lockInfos {
+				storage.EXPECT().GetInstallDir(info.Name, info.Version).Return(installDirs[info.Name])
+			}
+			for _, repo := range tc.gitRepos {
+				module := models.NewModule(repo.URL)
+				for _, info := range tc.lockInfos {
+					if info.Name == module.Name {
+						lockFile.EXPECT().Read(module.Name).Return(info, nil)
+					}
+				}
+			}
+			for _, removed := range tc.want.Removed {
+				if !slices.ContainsFunc(tc.wantWrites, func(info models.LockFileInfo) bool { return info.Name == removed }) {
+					lockFile.EXPECT().Remove(removed).Return(nil)
+				}
+			}
+			for _, info := range tc.wantWrites {
+				lockFile.EXPECT().Write(info.Name, info.Version, info.Hash, info.Indirect).Return(nil)
+			}
+
+			inputs := Inputs{InputGitRepos: tc.gitRepos}
+			workingRoot := tc.workingRoot
+			if workingRoot == "" {
+				workingRoot = "."
+				inputs.InputFilesDir = []InputFilesDir{{Path: ".", Root: filepath.Join(modImportsDir, "workspace")}}
+			}
+
+			c := &Core{
+				logger:   logger.NewNop(),
+				lockFile: lockFile,
+				storage:  storage,
+				inputs:   inputs,
+			}
+
+			got, err := c.tidy(context.Background(), workingRoot, fakeLoader(registry))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// ModuleWhy explains why module is needed by the shortest chain of imports
+// from a workspace proto file to a proto file of the module.
+type ModuleWhy struct {
+	Module string `json:"module"`
+	// Imports contains import paths starting with the workspace file,
+	// it is empty if workspace doesn't import any file of the module.
+	Imports []string `json:"imports"`
+}
+
+// Why returns import chain for the module from lock file.
+func (c *Core) Why(ctx context.Context, workingRoot string, moduleName string) (ModuleWhy, error) {
+	if err := c.Download(ctx); err != nil {
+		return ModuleWhy{}, fmt.Errorf("c.Download: %w", err)
+	}
+
+	return c.why(ctx, workingRoot, moduleName)
+}
+
+func (c *Core) why(ctx context.Context, workingRoot string, moduleName string) (ModuleWhy, error) {
+	lockFileInfo, err := c.lockFile.Read(moduleName)
+	if err != nil {
+		return ModuleWhy{}, fmt.Errorf("c.lockFile.Read: %w", err)
+	}
+
+	moduleRoot := normalizeAbsPath(c.storage.GetInstallDir(lockFileInfo.Name, lockFileInfo.Version))
+
+	state := c.workspaceImports(ctx, workingRoot)
+
+	res := ModuleWhy{
+		Module:  moduleName,
+		Imports: make([]string, 0),
+	}
+
+	// files are discovered breadth-first, so the first one has the shortest chain
+	for _, f := range state.files {
+		if f.Root != moduleRoot || f.Source != FileSourceDependency {
+			continue
+		}
+
+		res.Imports = state.importChain(f.AbsPath)
+		break
+	}
+
+	return res, nil
+}
+
+// importChain returns import paths from the workspace file to the passed one.
+func (s *lsContext) importChain(absPath string) []string {
+	importPaths := make(map[string]string, len(s.files))
+	for _, f := range s.files {
+		importPaths[f.AbsPath] = f.ImportPath
+	}
+
+	chain := []string{importPaths[absPath]}
+	for current, ok := s.importedBy[absPath]; ok; current, ok = s.importedBy[current] {
+		chain = append(chain, importPaths[current])
+	}
+	slices.Reverse(chain)
+
+	return chain
+}
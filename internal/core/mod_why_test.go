@@ -0,0 +1,88 @@
+package core
+
+import (
+	"context"
+	"iter"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+const modImportsDir = "../../testdata/mod_imports"
+
+func TestCore_why(t *testing.T) {
+	t.Parallel()
+
+	lockInfos := []models.LockFileInfo{
+		{Name: "github.com/googleapis/googleapis", Version: "v1.3.0"},
+		{Name: "github.com/acme/unused", Version: "v0.1.0", Indirect: true},
+	}
+	installDirs := map[string]string{
+		"github.com/googleapis/googleapis": filepath.Join(modImportsDir, "deps", "googleapis"),
+		"github.com/acme/unused":           filepath.Join(modImportsDir, "deps", "unused"),
+	}
+
+	tests := map[string]struct {
+		module string
+		want   ModuleWhy
+	}{
+		"imported": {
+			module: "github.com/googleapis/googleapis",
+			want: ModuleWhy{
+				Module: "github.com/googleapis/googleapis",
+				Imports: []string{
+					"api/v1/service.proto",
+					"google/api/annotations.proto",
+				},
+			},
+		},
+		"not imported": {
+			module: "github.com/acme/unused",
+			want: ModuleWhy{
+				Module:  "github.com/acme/unused",
+				Imports: []string{},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lockFile := NewLockFileMock(t)
+			storage := NewStorageMock(t)
+
+			var depsIter iter.Seq[models.LockFileInfo] = func(yield func(models.LockFileInfo) bool) {
+				for _, info := range lockInfos {
+					if !yield(info) {
+						return
+					}
+				}
+			}
+			lockFile.EXPECT().DepsIter().Return(depsIter)
+			for _, info := range lockInfos {
+				if info.Name == tc.module {
+					lockFile.EXPECT().Read(tc.module).Return(info, nil)
+				}
+				storage.EXPECT().GetInstallDir(info.Name, info.Version).Return(installDirs[info.Name])
+			}
+
+			c := &Core{
+				logger:   logger.NewNop(),
+				lockFile: lockFile,
+				storage:  storage,
+				inputs: Inputs{
+					InputFilesDir: []InputFilesDir{{Path: ".", Root: filepath.Join(modImportsDir, "workspace")}},
+				},
+			}
+
+			got, err := c.why(context.Background(), ".", tc.module)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -9,6 +9,8 @@ type LockFileInfo struct {
 	Name    string
 	Version string
 	Hash    ModuleHash
+	// Indirect is set for modules required only by other dependencies
+	Indirect bool
 }
 
 var (
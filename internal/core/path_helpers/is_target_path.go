@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
 )
 
 // IsTargetPath check if passed filePath is target
@@ -20,19 +22,55 @@ func IsTargetPath(targetPath, filePath string) bool {
 	return true
 }
 
+// IsIgnoredPath check if passed path matches any of ignore entries, see MatchPath.
 func IsIgnoredPath(path string, ignore []string) bool {
-	up := ".." + string(os.PathSeparator)
-
 	for _, ignorePath := range ignore {
-		rel, err := filepath.Rel(ignorePath, path)
-		if err != nil {
-			continue
+		if MatchPath(ignorePath, path) {
+			return true
 		}
-		if strings.HasPrefix(rel, up) && rel != ".." {
-			continue
-		}
-		return true
 	}
 
 	return false
 }
+
+// MatchPath check if passed path matches pattern.
+// Pattern is either a dir or file path (path matches if it is inside)
+// or a doublestar glob like "**/*_internal.proto" or "vendor/**/legacy/*".
+func MatchPath(pattern, path string) bool {
+	if IsGlob(pattern) {
+		return MatchGlob(pattern, path)
+	}
+
+	rel, err := filepath.Rel(pattern, path)
+	if err != nil {
+		return false
+	}
+
+	up := ".." + string(os.PathSeparator)
+
+	return !strings.HasPrefix(rel, up) || rel == ".."
+}
+
+// IsGlob check if passed pattern contains glob meta characters.
+func IsGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[{")
+}
+
+// MatchGlob check if passed path or any of its parent dirs matches doublestar glob,
+// so "vendor/**/legacy" matches all files inside legacy dirs.
+func MatchGlob(pattern, path string) bool {
+	pattern = filepath.ToSlash(filepath.Clean(pattern))
+	path = filepath.ToSlash(filepath.Clean(path))
+
+	for {
+		if ok, _ := doublestar.Match(pattern, path); ok {
+			return true
+		}
+
+		i := strings.LastIndexByte(path, '/')
+		if i < 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
@@ -110,6 +110,41 @@ func Test_IsIgnoredPath(t *testing.T) {
 			ignore:     []string{"some/path"},
 			expected:   true,
 		},
+		"ignored (glob file)": {
+			targetPath: "some/path/user_internal.proto",
+			ignore:     []string{"**/*_internal.proto"},
+			expected:   true,
+		},
+		"ignored (glob file in root)": {
+			targetPath: "user_internal.proto",
+			ignore:     []string{"**/*_internal.proto"},
+			expected:   true,
+		},
+		"not ignored (glob file)": {
+			targetPath: "some/path/user.proto",
+			ignore:     []string{"**/*_internal.proto"},
+			expected:   false,
+		},
+		"ignored (glob dir in the middle)": {
+			targetPath: "vendor/google/api/legacy/http.proto",
+			ignore:     []string{"vendor/**/legacy/*"},
+			expected:   true,
+		},
+		"ignored (glob dir)": {
+			targetPath: "vendor/google/legacy/v1/http.proto",
+			ignore:     []string{"vendor/**/legacy"},
+			expected:   true,
+		},
+		"not ignored (glob dir)": {
+			targetPath: "google/legacy/http.proto",
+			ignore:     []string{"vendor/**/legacy/*"},
+			expected:   false,
+		},
+		"ignored (glob alternatives)": {
+			targetPath: "api/v1alpha/service.proto",
+			ignore:     []string{"api/{v1alpha,v1beta}"},
+			expected:   true,
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/bufbuild/protocompile/protoutil"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// compileProtoFile compiles and links proto file with all its imports.
+// Use protoCompiler to compile many files sharing their imports.
+func (c *Core) compileProtoFile(ctx context.Context, disk FS, path string) (linker.Result, error) {
+	return c.newProtoCompiler(disk).compile(ctx, path)
+}
+
+// protoCompiler compiles proto files one by one and keeps compiled files,
+// so every file of the import graph is compiled once: imports of the next file
+// are taken from already compiled ones instead of being compiled again.
+// Imports are resolved the same way as for go-protoparser: locally, from deps
+// and from well known imports.
+// AST is retained so descriptors can be mapped back to positions in the source file.
+//
+// It is not safe for concurrent use: files are compiled sequentially,
+// so every path has a single descriptor and descriptors of different files can be linked together.
+type protoCompiler struct {
+	open     func(ctx context.Context, name string) (io.ReadCloser, error)
+	compiled map[string]linker.Result
+}
+
+func (c *Core) newProtoCompiler(disk FS) *protoCompiler {
+	return &protoCompiler{
+		open: func(ctx context.Context, name string) (io.ReadCloser, error) {
+			return c.openImportFile(ctx, disk, name)
+		},
+		compiled: make(map[string]linker.Result),
+	}
+}
+
+// compile compiles proto file, already compiled files are used as is.
+func (p *protoCompiler) compile(ctx context.Context, path string) (linker.Result, error) {
+	if res, ok := p.compiled[path]; ok {
+		return res, nil
+	}
+
+	source := &protocompile.SourceResolver{
+		Accessor: func(name string) (io.ReadCloser, error) {
+			return p.open(ctx, name)
+		},
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.ResolverFunc(func(name string) (protocompile.SearchResult, error) {
+			// map is only read while compiling, so concurrent lookups of the compiler are safe
+			if res, ok := p.compiled[name]; ok {
+				return protocompile.SearchResult{Desc: res}, nil
+			}
+
+			return source.FindFileByPath(name)
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+		RetainASTs:     true,
+	}
+
+	files, err := compiler.Compile(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("compiler.Compile: %w", err)
+	}
+
+	res, ok := files[0].(linker.Result)
+	if !ok {
+		return nil, fmt.Errorf("unexpected compile result type %T", files[0])
+	}
+
+	p.add(res)
+
+	return res, nil
+}
+
+// add keeps compiled file with its imports.
+func (p *protoCompiler) add(res linker.Result) {
+	if _, ok := p.compiled[res.Path()]; ok {
+		return
+	}
+
+	p.compiled[res.Path()] = res
+
+	imports := res.Imports()
+	for i := range imports.Len() {
+		if dep, ok := imports.Get(i).FileDescriptor.(linker.Result); ok {
+			p.add(dep)
+		}
+	}
+}
+
+// DescriptorPosition returns position of passed descriptor in its source file.
+func DescriptorPosition(d protoreflect.Descriptor) meta.Position {
+	file := d.ParentFile()
+	if file == nil {
+		return meta.Position{}
+	}
+
+	if res, ok := file.(linker.Result); ok && res.AST() != nil {
+		node := res.Node(protoutil.ProtoFromDescriptor(d))
+		if node != nil {
+			start := res.AST().NodeInfo(node).Start()
+			return meta.Position{
+				Offset: start.Offset,
+				Line:   start.Line,
+				Column: start.Col,
+			}
+		}
+	}
+
+	loc := file.SourceLocations().ByDescriptor(d)
+	if loc.Path == nil {
+		return meta.Position{}
+	}
+
+	return meta.Position{
+		Line:   loc.StartLine + 1,
+		Column: loc.StartColumn + 1,
+	}
+}
+
+// OptionPosition returns position of descriptor's option with passed name parts,
+// e.g. "buf.validate.field", "string", "min_len" for `(buf.validate.field).string.min_len`.
+// The option which matches the most name parts is chosen.
+// Position of the descriptor is returned if there is no such option in the source file.
+func OptionPosition(d protoreflect.Descriptor, name ...string) meta.Position {
+	res, ok := d.ParentFile().(linker.Result)
+	if !ok || res.AST() == nil || len(name) == 0 {
+		return DescriptorPosition(d)
+	}
+
+	node, ok := res.Node(protoutil.ProtoFromDescriptor(d)).(ast.NodeWithOptions)
+	if !ok {
+		return DescriptorPosition(d)
+	}
+
+	var (
+		found   *ast.OptionNode
+		matched int
+	)
+	node.RangeOptions(func(option *ast.OptionNode) bool {
+		n := matchOptionName(option.Name, name)
+		if n > matched {
+			found, matched = option, n
+		}
+		return matched < len(name)
+	})
+	if found == nil {
+		return DescriptorPosition(d)
+	}
+
+	start := res.AST().NodeInfo(found).Start()
+	return meta.Position{
+		Offset: start.Offset,
+		Line:   start.Line,
+		Column: start.Col,
+	}
+}
+
+// matchOptionName returns count of matched name parts of the option.
+func matchOptionName(optionName *ast.OptionNameNode, name []string) int {
+	matched := 0
+	for i, part := range optionName.Parts {
+		if i >= len(name) {
+			break
+		}
+
+		partName := strings.TrimPrefix(string(part.Name.AsIdentifier()), ".")
+		if partName != name[i] && !strings.HasSuffix(name[i], "."+partName) {
+			break
+		}
+		matched++
+	}
+
+	return matched
+}
+
+// AppendDescriptorIssue the same as AppendIssue but takes position from descriptor.
+func AppendDescriptorIssue(issues []Issue, lintRule Rule, d protoreflect.Descriptor, sourceName string) []Issue {
+	return AppendIssue(issues, lintRule, DescriptorPosition(d), sourceName)
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/fs/fs"
+	"github.com/easyp-tech/easyp/internal/logger"
+)
+
+// countingFS counts opened files.
+type countingFS struct {
+	FS
+	opened map[string]int
+}
+
+func (f *countingFS) Open(name string) (io.ReadCloser, error) {
+	f.opened[name]++
+	return f.FS.Open(name)
+}
+
+func TestProtoCompiler_SharesImports(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"common.proto": "syntax = \"proto3\";\npackage acme;\nmessage Common {}\n",
+		"a.proto":      "syntax = \"proto3\";\npackage acme;\nimport \"common.proto\";\nmessage A { Common common = 1; }\n",
+		"b.proto":      "syntax = \"proto3\";\npackage acme;\nimport \"common.proto\";\nmessage B { Common common = 1; }\n",
+		"broken.proto": "syntax = \"proto3\";\npackage acme;\nmessage Broken { Unknown unknown = 1; }\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	c := &Core{logger: logger.NewNop()}
+	disk := &countingFS{FS: fs.NewFSWalker(dir, "."), opened: make(map[string]int)}
+	compiler := c.newProtoCompiler(disk)
+
+	a, err := compiler.compile(context.Background(), "a.proto")
+	require.NoError(t, err)
+
+	// failed file doesn't break compilation of next ones
+	_, err = compiler.compile(context.Background(), "broken.proto")
+	require.Error(t, err)
+
+	b, err := compiler.compile(context.Background(), "b.proto")
+	require.NoError(t, err)
+
+	common, err := compiler.compile(context.Background(), "common.proto")
+	require.NoError(t, err)
+
+	require.Same(t, a.Imports().Get(0).FileDescriptor, b.Imports().Get(0).FileDescriptor)
+	require.Same(t, common, b.Imports().Get(0).FileDescriptor)
+	require.Equal(t, 1, disk.opened["common.proto"])
+}
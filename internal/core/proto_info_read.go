@@ -73,19 +73,44 @@ func (c *Core) readFilesFromImport(
 }
 
 func (c *Core) readFileFromImport(ctx context.Context, disk FS, importName string) (*unordered.Proto, error) {
+	f, err := c.openImportFile(ctx, disk, importName)
+	if err != nil {
+		return nil, err
+	}
+	defer c.close(ctx, f, importName)
+
+	proto, err := readProtoFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("readProtoFile: %w, path: %s", err, importName)
+	}
+
+	return proto, nil
+}
+
+// openImportFile opens imported file: locally, from deps or from well known imports.
+func (c *Core) openImportFile(_ context.Context, disk FS, importName string) (io.ReadCloser, error) {
 	// first try to read it locally
 	f, err := disk.Open(importName)
 	if err == nil {
-		// locally import
-		defer c.close(ctx, f, importName)
+		return f, nil
+	}
 
-		proto, err := readProtoFile(f)
-		if err != nil {
-			return nil, fmt.Errorf("readProtoFile: %w, path: %s", err, importName)
-		}
-		return proto, nil
+	return c.openDependencyFile(importName)
+}
+
+// isDependencyFile reports whether the file is provided by deps or well known imports.
+func (c *Core) isDependencyFile(name string) bool {
+	f, err := c.openDependencyFile(name)
+	if err != nil {
+		return false
 	}
+	_ = f.Close()
+
+	return true
+}
 
+// openDependencyFile opens file from deps or from well known imports.
+func (c *Core) openDependencyFile(importName string) (io.ReadCloser, error) {
 	for _, dep := range c.deps {
 		module := models.NewModule(dep)
 
@@ -97,7 +122,7 @@ func (c *Core) readFileFromImport(ctx context.Context, disk FS, importName strin
 		modulePath := c.storage.GetInstallDir(lockFileInfo.Name, lockFileInfo.Version)
 
 		fullPath := filepath.Join(modulePath, importName)
-		f, err = os.Open(fullPath)
+		f, err := os.Open(fullPath)
 		if err != nil {
 			if os.IsNotExist(err) {
 				continue
@@ -105,31 +130,18 @@ func (c *Core) readFileFromImport(ctx context.Context, disk FS, importName strin
 
 			return nil, fmt.Errorf("os.Open: %w", err)
 		}
-		defer c.close(ctx, f, fullPath)
-
-		proto, err := readProtoFile(f)
-		if err != nil {
-			return nil, fmt.Errorf("readProtoFile: %w, path: %s", err, importName)
-		}
 
-		return proto, nil
+		return f, nil
 	}
 
-	f, err = wellknownimports.Content.Open(importName)
+	f, err := wellknownimports.Content.Open(importName)
 	if err != nil {
 		if os.IsNotExist(err) {
-			//return nil, ErrOpenImportFile
 			return nil, &OpenImportFileError{FileName: importName}
 		}
 
 		return nil, fmt.Errorf("os.Open: %w", err)
 	}
-	defer c.close(ctx, f, importName)
 
-	proto, err := readProtoFile(f)
-	if err != nil {
-		return nil, fmt.Errorf("readProtoFile: %w, path: %s", err, importName)
-	}
-
-	return proto, nil
+	return f, nil
 }
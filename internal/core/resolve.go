@@ -0,0 +1,342 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/easyp-tech/easyp/internal/adapters/repository/git"
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+// ErrDependencyConflict is returned when required versions of a module can not be unified.
+var ErrDependencyConflict = errors.New("dependency versions conflict")
+
+const rootRequirement = "easyp.yaml"
+
+type (
+	// DependencyRequirement is an edge of the dependency graph: a module version required by Path.
+	DependencyRequirement struct {
+		// Path chain of modules (name@version) which leads to the requirement, empty for easyp.yaml.
+		Path []string
+		// Module requested module with its version from config.
+		Module models.Module
+		// Revision resolved revision of the requested version.
+		Revision models.Revision
+	}
+
+	// DependencyConflict collects requirements of the module which versions can not be unified.
+	DependencyConflict struct {
+		ModuleName   string
+		Requirements []DependencyRequirement
+	}
+
+	// DependencyConflictError is returned by resolution if any conflicts were found.
+	DependencyConflictError struct {
+		Conflicts []DependencyConflict
+	}
+
+	// resolvedModule is a module version selected by resolution.
+	resolvedModule struct {
+		// Module contains resolved revision version as its version.
+		Module   models.Module
+		Revision models.Revision
+		Indirect bool
+	}
+
+	// requirementsLoader reads revision and dependencies of the module without installing it.
+	requirementsLoader func(ctx context.Context, module models.Module) (models.Revision, []models.Module, error)
+)
+
+// Error implements error interface with readable report about every conflict.
+func (e *DependencyConflictError) Error() string {
+	b := strings.Builder{}
+	b.WriteString(ErrDependencyConflict.Error())
+	b.WriteString(":")
+
+	for _, conflict := range e.Conflicts {
+		b.WriteString("\n  " + conflict.ModuleName + ":")
+
+		for _, req := range conflict.Requirements {
+			b.WriteString("\n    " + req.version() + " required by " + req.requiredBy())
+		}
+	}
+
+	return b.String()
+}
+
+func (e *DependencyConflictError) Unwrap() error {
+	return ErrDependencyConflict
+}
+
+func (r DependencyRequirement) version() string {
+	requested := string(r.Module.Version)
+	if r.Module.Version.IsOmitted() {
+		requested = "latest"
+	}
+
+	if requested == r.Revision.Version {
+		return requested
+	}
+
+	return requested + " (" + r.Revision.Version + ")"
+}
+
+func (r DependencyRequirement) requiredBy() string {
+	return strings.Join(append([]string{rootRequirement}, r.Path...), " -> ")
+}
+
+// isComparable check if requirement could be compared with others by semver.
+// Branches, commits and non semver tags are compared only by commit.
+func (r DependencyRequirement) isComparable() bool {
+	switch {
+	case r.Module.Version.IsGenerated(), semver.IsValid(string(r.Module.Version)):
+		return true
+	case r.Module.Version.IsOmitted():
+		return semver.IsValid(r.Revision.Version)
+	default:
+		return false
+	}
+}
+
+// resolveDependencies builds the full dependency graph from roots and selects
+// a single version for every module.
+func (c *Core) resolveDependencies(ctx context.Context, roots []models.Module) ([]resolvedModule, error) {
+	return resolveDependencies(ctx, roots, c.loadRequirements)
+}
+
+// loadRequirements reads revision and dependencies from module's config.
+func (c *Core) loadRequirements(
+	ctx context.Context, module models.Module,
+) (models.Revision, []models.Module, error) {
+	cacheRepositoryDir, err := c.storage.CreateCacheRepositoryDir(module.Name)
+	if err != nil {
+		return models.Revision{}, nil, fmt.Errorf("c.storage.CreateCacheRepositoryDir: %w", err)
+	}
+	// TODO: use factory (git, svn etc)
+	repo, err := git.New(ctx, module.Name, cacheRepositoryDir, c.console)
+	if err != nil {
+		return models.Revision{}, nil, fmt.Errorf("git.New: %w", err)
+	}
+
+	revision, err := repo.ReadRevision(ctx, module.Version)
+	if err != nil {
+		return models.Revision{}, nil, fmt.Errorf("repository.ReadRevision: %w", err)
+	}
+
+	if err := repo.Fetch(ctx, revision); err != nil {
+		return models.Revision{}, nil, fmt.Errorf("repository.Fetch: %w", err)
+	}
+
+	moduleConfig, err := c.moduleConfig.ReadFromRepo(ctx, repo, revision)
+	if err != nil {
+		return models.Revision{}, nil, fmt.Errorf("c.moduleConfig.ReadFromRepo: %w", err)
+	}
+
+	return revision, moduleConfig.Dependencies, nil
+}
+
+// resolveDependencies works like minimal version selection from go mod:
+// every reachable module version is visited and the maximum required semver is selected.
+// Requirements which can't be ordered (branches, commits) pin the version, see selectRequirement.
+func resolveDependencies(
+	ctx context.Context, roots []models.Module, load requirementsLoader,
+) ([]resolvedModule, error) {
+	graph, err := buildDependencyGraph(ctx, roots, load)
+	if err != nil {
+		return nil, fmt.Errorf("buildDependencyGraph: %w", err)
+	}
+
+	var conflicts []DependencyConflict
+	resolved := make([]resolvedModule, 0, len(graph.names))
+
+	for _, name := range graph.names {
+		selected, ok := selectRequirement(graph.requirements[name])
+		if !ok {
+			conflicts = append(conflicts, DependencyConflict{ModuleName: name, Requirements: graph.requirements[name]})
+			continue
+		}
+
+		resolved = append(resolved, resolvedModule{
+			Module:   models.Module{Name: name, Version: models.RequestedVersion(selected.Revision.Version)},
+			Revision: selected.Revision,
+			Indirect: !graph.direct[name],
+		})
+	}
+
+	if len(conflicts) > 0 {
+		return nil, &DependencyConflictError{Conflicts: conflicts}
+	}
+
+	return resolved, nil
+}
+
+// dependencyGraph contains every requirement reachable from roots.
+type dependencyGraph struct {
+	// names sorted names of all modules in graph
+	names []string
+	// requirements of the module by its name in order of visiting
+	requirements map[string][]DependencyRequirement
+	// direct is set for modules required by roots
+	direct map[string]bool
+}
+
+// buildDependencyGraph visits every module version reachable from roots.
+func buildDependencyGraph(
+	ctx context.Context, roots []models.Module, load requirementsLoader,
+) (dependencyGraph, error) {
+	type (
+		node struct {
+			module models.Module
+			path   []string
+		}
+		loaded struct {
+			revision models.Revision
+			requires []models.Module
+		}
+	)
+
+	graph := dependencyGraph{
+		requirements: make(map[string][]DependencyRequirement),
+		direct:       make(map[string]bool, len(roots)),
+	}
+
+	queue := make([]node, 0, len(roots))
+	for _, root := range roots {
+		graph.direct[root.Name] = true
+		queue = append(queue, node{module: root})
+	}
+
+	cache := make(map[models.Module]loaded)
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		l, visited := cache[n.module]
+		if !visited {
+			revision, requires, err := load(ctx, n.module)
+			if err != nil {
+				return dependencyGraph{}, fmt.Errorf("load %s@%s required by %s: %w",
+					n.module.Name, n.module.Version, DependencyRequirement{Path: n.path}.requiredBy(), err)
+			}
+
+			l = loaded{revision: revision, requires: requires}
+			cache[n.module] = l
+		}
+
+		graph.requirements[n.module.Name] = append(graph.requirements[n.module.Name], DependencyRequirement{
+			Path:     n.path,
+			Module:   n.module,
+			Revision: l.revision,
+		})
+
+		if visited {
+			continue
+		}
+
+		path := append(slices.Clone(n.path), n.module.Name+"@"+l.revision.Version)
+		for _, dep := range l.requires {
+			queue = append(queue, node{module: dep, path: path})
+		}
+	}
+
+	graph.names = make([]string, 0, len(graph.requirements))
+	for name := range graph.requirements {
+		graph.names = append(graph.names, name)
+	}
+	sort.Strings(graph.names)
+
+	return graph, nil
+}
+
+// selectRequirement returns requirement with the maximum version.
+// Requirements which can't be compared (branches, commits) pin the module:
+// all of them have to point to the same commit and it has to be not lower than any other required version.
+func selectRequirement(requirements []DependencyRequirement) (DependencyRequirement, bool) {
+	var selected, pinned *DependencyRequirement
+
+	for i, req := range requirements {
+		if req.isComparable() {
+			if selected == nil || semver.Compare(req.Revision.Version, selected.Revision.Version) > 0 {
+				selected = &requirements[i]
+			}
+
+			continue
+		}
+
+		if pinned == nil {
+			pinned = &requirements[i]
+		}
+		if req.Revision.CommitHash != pinned.Revision.CommitHash {
+			return DependencyRequirement{}, false
+		}
+	}
+
+	if pinned == nil {
+		return *selected, true
+	}
+
+	if selected != nil && selected.Revision.CommitHash != pinned.Revision.CommitHash &&
+		semver.Compare(selected.Revision.Version, pinned.Revision.Version) >= 0 {
+		return DependencyRequirement{}, false
+	}
+
+	return *pinned, true
+}
+
+// installResolved resolves dependencies graph from roots, installs selected versions and writes lock file.
+// Modules which are not in the graph anymore are removed from lock file.
+func (c *Core) installResolved(ctx context.Context, roots []models.Module) error {
+	modules, err := c.resolveDependencies(ctx, roots)
+	if err != nil {
+		return fmt.Errorf("c.resolveDependencies: %w", err)
+	}
+
+	selected := make(map[string]struct{}, len(modules))
+	for _, module := range modules {
+		selected[module.Module.Name] = struct{}{}
+	}
+
+	var stale []string
+	for lockFileInfo := range c.lockFile.DepsIter() {
+		if _, ok := selected[lockFileInfo.Name]; !ok {
+			stale = append(stale, lockFileInfo.Name)
+		}
+	}
+
+	for _, moduleName := range stale {
+		c.logger.Debug(ctx, "removing module from lock file", slog.String("module", moduleName))
+
+		if err := c.lockFile.Remove(moduleName); err != nil {
+			return fmt.Errorf("c.lockFile.Remove: %w", err)
+		}
+	}
+
+	for _, module := range modules {
+		c.logger.Debug(ctx, "installing resolved module",
+			slog.String("module", module.Module.Name),
+			slog.String("version", string(module.Module.Version)),
+			slog.Bool("indirect", module.Indirect),
+		)
+
+		installedModuleInfo, err := c.install(ctx, module.Module)
+		if err != nil {
+			return fmt.Errorf("c.install: %w", err)
+		}
+
+		if err := c.lockFile.Write(
+			module.Module.Name, installedModuleInfo.RevisionVersion, installedModuleInfo.Hash, module.Indirect,
+		); err != nil {
+			return fmt.Errorf("c.lockFile.Write: %w", err)
+		}
+	}
+
+	return nil
+}
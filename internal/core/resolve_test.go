@@ -0,0 +1,218 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/easyp-tech/easyp/internal/core/models"
+)
+
+// fakeModuleVersion is a module version in the fake registry.
+type fakeModuleVersion struct {
+	revision models.Revision
+	requires []string
+}
+
+func fakeLoader(registry map[string]fakeModuleVersion) requirementsLoader {
+	return func(_ context.Context, module models.Module) (models.Revision, []models.Module, error) {
+		v, ok := registry[module.Name+"@"+string(module.Version)]
+		if !ok {
+			return models.Revision{}, nil, models.ErrVersionNotFound
+		}
+
+		requires := make([]models.Module, 0, len(v.requires))
+		for _, dep := range v.requires {
+			requires = append(requires, models.NewModule(dep))
+		}
+
+		return v.revision, requires, nil
+	}
+}
+
+func TestResolveDependencies(t *testing.T) {
+	t.Parallel()
+
+	registry := map[string]fakeModuleVersion{
+		"github.com/acme/api@v1.0.0": {
+			revision: models.Revision{CommitHash: "a1", Version: "v1.0.0"},
+			requires: []string{"github.com/googleapis/googleapis@v1.1.0", "github.com/acme/types@v0.1.0"},
+		},
+		"github.com/acme/types@v0.1.0": {
+			revision: models.Revision{CommitHash: "t1", Version: "v0.1.0"},
+			requires: []string{"github.com/googleapis/googleapis@v1.3.0", "github.com/acme/api@v1.0.0"},
+		},
+		"github.com/googleapis/googleapis@v1.1.0": {
+			revision: models.Revision{CommitHash: "g1", Version: "v1.1.0"},
+		},
+		"github.com/googleapis/googleapis@v1.2.0": {
+			revision: models.Revision{CommitHash: "g2", Version: "v1.2.0"},
+		},
+		"github.com/googleapis/googleapis@v1.3.0": {
+			revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+		},
+		"github.com/googleapis/googleapis@main": {
+			revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+		},
+		"github.com/googleapis/googleapis@dev": {
+			revision: models.Revision{CommitHash: "g4", Version: "v0.0.0-20250101000000-g4"},
+		},
+		"github.com/googleapis/googleapis@": {
+			revision: models.Revision{CommitHash: "g4", Version: "v0.0.0-20250101000000-g4"},
+		},
+	}
+
+	tests := map[string]struct {
+		roots     []string
+		want      []resolvedModule
+		conflicts []DependencyConflict
+		wantErr   error
+	}{
+		"maximum version is selected": {
+			roots: []string{"github.com/acme/api@v1.0.0", "github.com/googleapis/googleapis@v1.2.0"},
+			want: []resolvedModule{
+				{
+					Module:   models.Module{Name: "github.com/acme/api", Version: "v1.0.0"},
+					Revision: models.Revision{CommitHash: "a1", Version: "v1.0.0"},
+				},
+				{
+					Module:   models.Module{Name: "github.com/acme/types", Version: "v0.1.0"},
+					Revision: models.Revision{CommitHash: "t1", Version: "v0.1.0"},
+					Indirect: true,
+				},
+				{
+					Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "v1.3.0"},
+					Revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+				},
+			},
+		},
+		"branch on the same commit as tag": {
+			roots: []string{"github.com/acme/types@v0.1.0", "github.com/googleapis/googleapis@main"},
+			want: []resolvedModule{
+				{
+					Module:   models.Module{Name: "github.com/acme/api", Version: "v1.0.0"},
+					Revision: models.Revision{CommitHash: "a1", Version: "v1.0.0"},
+					Indirect: true,
+				},
+				{
+					Module:   models.Module{Name: "github.com/acme/types", Version: "v0.1.0"},
+					Revision: models.Revision{CommitHash: "t1", Version: "v0.1.0"},
+				},
+				{
+					Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "v1.3.0"},
+					Revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+				},
+			},
+		},
+		"omitted version is compared by resolved version": {
+			roots: []string{"github.com/googleapis/googleapis", "github.com/acme/types@v0.1.0"},
+			want: []resolvedModule{
+				{
+					Module:   models.Module{Name: "github.com/acme/api", Version: "v1.0.0"},
+					Revision: models.Revision{CommitHash: "a1", Version: "v1.0.0"},
+					Indirect: true,
+				},
+				{
+					Module:   models.Module{Name: "github.com/acme/types", Version: "v0.1.0"},
+					Revision: models.Revision{CommitHash: "t1", Version: "v0.1.0"},
+				},
+				{
+					Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "v1.3.0"},
+					Revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+				},
+			},
+		},
+		"branch conflicts with tag": {
+			roots: []string{"github.com/googleapis/googleapis@dev", "github.com/acme/api@v1.0.0"},
+			conflicts: []DependencyConflict{
+				{
+					ModuleName: "github.com/googleapis/googleapis",
+					Requirements: []DependencyRequirement{
+						{
+							Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "dev"},
+							Revision: models.Revision{CommitHash: "g4", Version: "v0.0.0-20250101000000-g4"},
+						},
+						{
+							Path:     []string{"github.com/acme/api@v1.0.0"},
+							Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "v1.1.0"},
+							Revision: models.Revision{CommitHash: "g1", Version: "v1.1.0"},
+						},
+						{
+							Path:     []string{"github.com/acme/api@v1.0.0", "github.com/acme/types@v0.1.0"},
+							Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "v1.3.0"},
+							Revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+						},
+					},
+				},
+			},
+			wantErr: ErrDependencyConflict,
+		},
+		"version not found": {
+			roots:   []string{"github.com/googleapis/googleapis@v9.9.9"},
+			wantErr: models.ErrVersionNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			roots := make([]models.Module, 0, len(tc.roots))
+			for _, root := range tc.roots {
+				roots = append(roots, models.NewModule(root))
+			}
+
+			got, err := resolveDependencies(context.Background(), roots, fakeLoader(registry))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+
+				var conflictErr *DependencyConflictError
+				if errors.As(err, &conflictErr) {
+					require.Equal(t, tc.conflicts, conflictErr.Conflicts)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDependencyConflictError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := &DependencyConflictError{
+		Conflicts: []DependencyConflict{
+			{
+				ModuleName: "github.com/googleapis/googleapis",
+				Requirements: []DependencyRequirement{
+					{
+						Module:   models.Module{Name: "github.com/googleapis/googleapis"},
+						Revision: models.Revision{CommitHash: "g4", Version: "v0.0.0-20250101000000-g4"},
+					},
+					{
+						Path:     []string{"github.com/acme/api@v1.0.0"},
+						Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "main"},
+						Revision: models.Revision{CommitHash: "g3", Version: "v1.3.0"},
+					},
+					{
+						Path:     []string{"github.com/acme/api@v1.0.0", "github.com/acme/types@v0.1.0"},
+						Module:   models.Module{Name: "github.com/googleapis/googleapis", Version: "v1.1.0"},
+						Revision: models.Revision{CommitHash: "g1", Version: "v1.1.0"},
+					},
+				},
+			},
+		},
+	}
+
+	want := `dependency versions conflict:
+  github.com/googleapis/googleapis:
+    latest (v0.0.0-20250101000000-g4) required by easyp.yaml
+    main (v1.3.0) required by easyp.yaml -> github.com/acme/api@v1.0.0
+    v1.1.0 required by easyp.yaml -> github.com/acme/api@v1.0.0 -> github.com/acme/types@v0.1.0`
+
+	require.Equal(t, want, err.Error())
+}
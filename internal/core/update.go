@@ -12,23 +12,22 @@ import (
 // Update all packages from config
 // dependencies slice of strings format: origin@version: github.com/company/repository@v1.2.3
 // if version is absent use the latest commit
+// Indirect dependencies are resolved with the whole graph before installing.
 func (c *Core) Update(ctx context.Context) error {
 	c.logger.Info(ctx, "updating dependencies", slog.Int("count", len(c.deps)))
 
+	roots := make([]models.Module, 0, len(c.deps))
 	for _, dependency := range c.deps {
-		module := models.NewModule(dependency)
-		log := c.logger.With(slog.String("module", module.Name), slog.String("version", string(module.Version)))
-
-		log.Debug(ctx, "updating dependency")
-
-		if err := c.Get(ctx, module); err != nil {
-			if errors.Is(err, models.ErrVersionNotFound) {
-				log.Error(ctx, "version not found")
-				return models.ErrVersionNotFound
-			}
+		roots = append(roots, models.NewModule(dependency))
+	}
 
-			return fmt.Errorf("c.Get: %w", err)
+	if err := c.installResolved(ctx, roots); err != nil {
+		if errors.Is(err, models.ErrVersionNotFound) {
+			c.logger.Error(ctx, "version not found", slog.String("error", err.Error()))
+			return models.ErrVersionNotFound
 		}
+
+		return fmt.Errorf("c.installResolved: %w", err)
 	}
 
 	c.logger.Info(ctx, "update completed")
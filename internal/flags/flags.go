@@ -43,7 +43,18 @@ var (
 		Required:   false,
 		HasBeenSet: false,
 		Value: &EnumValue{
-			Enum:    []string{TextFormat, JSONFormat},
+			Enum: []string{
+				TextFormat,
+				JSONFormat,
+				SARIFFormat,
+				JUnitFormat,
+				CheckstyleFormat,
+				GitHubActionsFormat,
+				GitLabCodeQualityFormat,
+				MarkdownFormat,
+				JSONReportFormat,
+				DOTFormat,
+			},
 			Default: "text",
 		},
 		Aliases: []string{"f"},
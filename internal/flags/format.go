@@ -8,6 +8,21 @@ import (
 const (
 	TextFormat = "text"
 	JSONFormat = "json"
+
+	// Formats of issues reports for CI, supported by lint and breaking.
+	SARIFFormat             = "sarif"
+	JUnitFormat             = "junit"
+	CheckstyleFormat        = "checkstyle"
+	GitHubActionsFormat     = "github-actions"
+	GitLabCodeQualityFormat = "gitlab-codequality"
+
+	// MarkdownFormat prints breaking report suitable for a pull request comment.
+	MarkdownFormat = "markdown"
+	// JSONReportFormat prints breaking report in json, json format prints issues like lint.
+	JSONReportFormat = "json-report"
+
+	// DOTFormat prints graphviz graph, supported by mod graph.
+	DOTFormat = "dot"
 )
 
 // GetFormat returns the format to use for the command, preferring the global
@@ -58,6 +58,13 @@ func (a *GitTreeDiskAdapter) Create(name string) (io.WriteCloser, error) {
 }
 
 func (a *GitTreeDiskAdapter) Exists(name string) bool {
+	// the same lookup order as in Open
+	if a.root != "" && isLocalPath(name) {
+		if _, err := a.File(path.Join(a.root, name)); err == nil {
+			return true
+		}
+	}
+
 	_, err := a.File(name)
 	return err == nil
 }
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/samber/lo"
 
@@ -48,19 +49,148 @@ func AllRuleNames() []string {
 // group keys, grouped rule names, and uncategorized rule names.
 func AllLintUseValues() []string {
 	groups := AllGroups()
-	values := make([]string, 0, len(groups)+len(AllRuleNames())+1)
+	values := make([]string, 0, len(groups)+len(AllRuleNames()))
 	for _, group := range groups {
 		values = append(values, group.Key)
 	}
 	values = append(values, AllRuleNames()...)
-	values = append(values, core.GetRuleName(&PackageNoImportCycle{}))
+	values = addUncategorized(values)
 
 	return lo.FindUniques(values)
 }
 
+// RuleInfo describes a builtin lint rule.
+type RuleInfo struct {
+	Name    string       `json:"name"`             // Rule name: "COMMENT_FIELD", ...
+	Message string       `json:"message"`          // Message of the rule issues.
+	Groups  []string     `json:"groups,omitempty"` // Keys of groups which contain the rule.
+	Doc     core.RuleDoc `json:"doc"`              // Documentation of the rule.
+}
+
+// AllRules returns descriptions of all builtin lint rules in canonical order.
+func AllRules() []RuleInfo {
+	groups := AllGroups()
+	rules := allRules(config.LintConfig{})
+
+	res := make([]RuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		info := RuleInfo{
+			Name:    core.GetRuleName(rule),
+			Message: rule.Message(),
+		}
+
+		if documented, ok := rule.(core.DocumentedRule); ok {
+			info.Doc = documented.Doc()
+		}
+
+		for _, group := range groups {
+			if lo.Contains(group.Rules, info.Name) {
+				info.Groups = append(info.Groups, group.Key)
+			}
+		}
+
+		res = append(res, info)
+	}
+
+	return res
+}
+
+// RuleByName returns description of the builtin lint rule by its name.
+func RuleByName(name string) (RuleInfo, error) {
+	info, ok := lo.Find(AllRules(), func(info RuleInfo) bool {
+		return info.Name == name
+	})
+	if !ok {
+		return RuleInfo{}, fmt.Errorf("%w: %s", core.ErrInvalidRule, name)
+	}
+
+	return info, nil
+}
+
 // New returns a map of rules and a map of ignore only rules by configuration.
 func New(cfg config.LintConfig) ([]core.Rule, map[string][]string, error) {
-	allRules := []core.Rule{
+	res, err := buildRules(cfg, useRules(cfg))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, unwrapIgnoreOnly(cfg.IgnoreOnly), nil
+}
+
+// Overrides returns rules of lint overrides by configuration.
+// Every override extends base configuration: its use and except are applied to the base rules
+// and its suffixes replace base ones.
+// Cross-file rules are taken from base rules, so they collect state of all files.
+func Overrides(cfg config.LintConfig, base []core.Rule) ([]core.LintOverride, error) {
+	baseCrossFileRules := make(map[string]core.Rule)
+	for _, rule := range base {
+		if _, ok := rule.(core.CrossFileRule); ok {
+			baseCrossFileRules[core.GetRuleName(rule)] = rule
+		}
+	}
+
+	res := make([]core.LintOverride, 0, len(cfg.Overrides))
+
+	for _, override := range cfg.Overrides {
+		overrideCfg := cfg
+		overrideCfg.EnumZeroValueSuffix = defaultIfEmpty(override.EnumZeroValueSuffix, cfg.EnumZeroValueSuffix)
+		overrideCfg.ServiceSuffix = defaultIfEmpty(override.ServiceSuffix, cfg.ServiceSuffix)
+
+		use := lo.Uniq(append(useRules(cfg), unwrapLintGroups(override.Use)...))
+		use = removeExcept(unwrapLintGroups(override.Except), use)
+
+		rules, err := buildRules(overrideCfg, use)
+		if err != nil {
+			return nil, fmt.Errorf("override %s: %w", override.Path, err)
+		}
+
+		for i, rule := range rules {
+			if baseRule, ok := baseCrossFileRules[core.GetRuleName(rule)]; ok {
+				rules[i] = baseRule
+			}
+		}
+
+		res = append(res, core.LintOverride{
+			Path:  override.Path,
+			Rules: rules,
+		})
+	}
+
+	return res, nil
+}
+
+// useRules returns names of rules enabled by use and not disabled by except.
+func useRules(cfg config.LintConfig) []string {
+	use := unwrapLintGroups(cfg.Use)
+
+	return removeExcept(unwrapLintGroups(cfg.Except), use)
+}
+
+// buildRules returns rules configured by cfg with passed names.
+func buildRules(cfg config.LintConfig, use []string) ([]core.Rule, error) {
+	rules := make(map[string]core.Rule)
+	for _, rule := range allRules(cfg) {
+		ruleName := core.GetRuleName(rule)
+		rules[ruleName] = rule
+	}
+
+	res := make([]core.Rule, len(use))
+
+	for i, ruleName := range use {
+		rule, ok := rules[ruleName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", core.ErrInvalidRule, ruleName)
+		}
+
+		res[i] = rule
+	}
+
+	return res, nil
+}
+
+// allRules returns all builtin rules configured by cfg.
+func allRules(cfg config.LintConfig) []core.Rule {
+	return []core.Rule{
 		//	minGroup
 		&DirectorySamePackage{},
 		&PackageDefined{},
@@ -68,6 +198,10 @@ func New(cfg config.LintConfig) ([]core.Rule, map[string][]string, error) {
 			Root: ".", // TODO: fix me
 		},
 		&PackageSameDirectory{},
+		&PackageNoImportCycle{},
+
+		//	uncategorized
+		&LintIgnoreUnused{},
 
 		//	basicGroup
 		&EnumFirstValueZero{},
@@ -75,6 +209,7 @@ func New(cfg config.LintConfig) ([]core.Rule, map[string][]string, error) {
 		&EnumPascalCase{},
 		&EnumValueUpperSnakeCase{},
 		&FieldLowerSnakeCase{},
+		&FieldNoDescriptor{},
 		&ImportNoPublic{},
 		&ImportNoWeak{},
 		&ImportUsed{},
@@ -90,19 +225,31 @@ func New(cfg config.LintConfig) ([]core.Rule, map[string][]string, error) {
 		&PackageSameSwiftPrefix{},
 		&RPCPascalCase{},
 		&ServicePascalCase{},
+		&SyntaxSpecified{},
+		&Protovalidate{},
 		//	defaultGroup
 		&EnumValuePrefix{},
 		&EnumZeroValueSuffix{
 			Suffix: defaultIfEmpty(cfg.EnumZeroValueSuffix, "UNSPECIFIED"),
 		},
+		&FieldNotRequired{},
 		&FileLowerSnakeCase{},
-		&RPCRequestResponseUnique{},
-		&RPCRequestStandardName{},
-		&RPCResponseStandardName{},
+		&RPCRequestResponseUnique{
+			AllowSameRequestResponse:          cfg.RPCAllowSameRequestResponse,
+			AllowGoogleProtobufEmptyRequests:  cfg.RPCAllowGoogleProtobufEmptyRequests,
+			AllowGoogleProtobufEmptyResponses: cfg.RPCAllowGoogleProtobufEmptyResponses,
+		},
+		&RPCRequestStandardName{
+			AllowGoogleProtobufEmptyRequests: cfg.RPCAllowGoogleProtobufEmptyRequests,
+		},
+		&RPCResponseStandardName{
+			AllowGoogleProtobufEmptyResponses: cfg.RPCAllowGoogleProtobufEmptyResponses,
+		},
 		&PackageVersionSuffix{},
 		&ServiceSuffix{
 			Suffix: defaultIfEmpty(cfg.ServiceSuffix, "Service"),
 		},
+		&StablePackageNoImportUnstable{},
 		//	commentsGroup
 		&CommentEnum{},
 		&CommentEnumValue{},
@@ -114,71 +261,101 @@ func New(cfg config.LintConfig) ([]core.Rule, map[string][]string, error) {
 		//	unaryRPCGroup
 		&RPCNoClientStreaming{},
 		&RPCNoServerStreaming{},
-		//	UNCATEGORIZED
-		&PackageNoImportCycle{},
 	}
+}
 
-	rules := make(map[string]core.Rule)
-	for _, rule := range allRules {
-		ruleName := core.GetRuleName(rule)
-		rules[ruleName] = rule
+// Severity returns a map of rule severities by configuration.
+// Groups are unwrapped, severity of a single rule overrides severity of its group.
+// Unknown rules and groups are rejected like in use and except, unless lint plugins are configured:
+// names of their rules are known only from reported issues, so unknown rules are returned to be reported.
+func Severity(cfg config.LintConfig) (map[string]core.Severity, []string, error) {
+	res := make(map[string]core.Severity)
+	var unknown []string
+
+	known := make(map[string]bool)
+	for _, rule := range allRules(cfg) {
+		known[core.GetRuleName(rule)] = true
 	}
 
-	use := unwrapLintGroups(cfg.Use)
-	use = removeExcept(unwrapLintGroups(cfg.Except), use)
+	// groups first, so single rules can override them
+	for _, onlyGroups := range []bool{true, false} {
+		for ruleOrGroup, level := range cfg.Severity {
+			ruleNames, isGroup := groupRules(ruleOrGroup)
+			if isGroup != onlyGroups {
+				continue
+			}
 
-	res := make([]core.Rule, len(use))
+			severity, err := core.ParseSeverity(level)
+			if err != nil {
+				return nil, nil, fmt.Errorf("core.ParseSeverity: %w", err)
+			}
 
-	for i, ruleName := range use {
-		rule, ok := rules[ruleName]
-		if !ok {
-			return nil, nil, fmt.Errorf("%w: %s", core.ErrInvalidRule, ruleName)
-		}
+			if !isGroup {
+				if !known[ruleOrGroup] {
+					if len(cfg.Plugins) == 0 {
+						return nil, nil, fmt.Errorf("%w: %s", core.ErrInvalidRule, ruleOrGroup)
+					}
 
-		res[i] = rule
+					unknown = append(unknown, ruleOrGroup)
+				}
+
+				ruleNames = []string{ruleOrGroup}
+			}
+
+			for _, ruleName := range ruleNames {
+				res[ruleName] = severity
+			}
+		}
 	}
 
-	return res, unwrapIgnoreOnly(cfg.IgnoreOnly), nil
+	slices.Sort(unknown)
+
+	return res, unknown, nil
 }
 
 func unwrapIgnoreOnly(ignoreOnly map[string][]string) map[string][]string {
 	res := make(map[string][]string)
 
 	for ruleName, fileOrDirs := range ignoreOnly {
-		switch ruleName {
-		case minGroup:
-			ruleNames := addMinimal(nil)
-			for i := range ruleNames {
-				res[ruleNames[i]] = fileOrDirs
-			}
-		case basicGroup:
-			ruleNames := addBasic(nil)
-			for i := range ruleNames {
-				res[ruleNames[i]] = fileOrDirs
-			}
-		case defaultGroup:
-			ruleNames := addDefault(nil)
-			for i := range ruleNames {
-				res[ruleNames[i]] = fileOrDirs
-			}
-		case commentsGroup:
-			ruleNames := addComments(nil)
-			for i := range ruleNames {
-				res[ruleNames[i]] = fileOrDirs
-			}
-		case unaryRPCGroup:
-			ruleNames := addUnary(nil)
-			for i := range ruleNames {
-				res[ruleNames[i]] = fileOrDirs
-			}
-		default:
+		ruleNames, ok := groupRules(ruleName)
+		if !ok {
 			res[ruleName] = fileOrDirs
+			continue
+		}
+
+		for i := range ruleNames {
+			res[ruleNames[i]] = fileOrDirs
 		}
 	}
 
 	return res
 }
 
+// GroupRules returns rule names by group key.
+func GroupRules() map[string][]string {
+	return lo.SliceToMap(AllGroups(), func(group RuleGroup) (string, []string) {
+		return group.Key, group.Rules
+	})
+}
+
+// groupRules returns rule names of the group, false is returned if passed name is not a group.
+func groupRules(group string) ([]string, bool) {
+	switch group {
+	case minGroup:
+		return addMinimal(nil), true
+	case basicGroup:
+		return addBasic(nil), true
+	case defaultGroup:
+		return addDefault(nil), true
+	case commentsGroup:
+		return addComments(nil), true
+	case unaryRPCGroup:
+		return addUnary(nil), true
+	default:
+		return nil, false
+	}
+}
+
 func unwrapLintGroups(use []string) []string {
 	var res []string
 
@@ -221,6 +398,14 @@ func addMinimal(res []string) []string {
 	res = append(res, core.GetRuleName(&PackageDefined{}))
 	res = append(res, core.GetRuleName(&PackageDirectoryMatch{}))
 	res = append(res, core.GetRuleName(&PackageSameDirectory{}))
+	res = append(res, core.GetRuleName(&PackageNoImportCycle{}))
+
+	return res
+}
+
+// addUncategorized adds rules which are not a part of any group, they have to be enabled explicitly.
+func addUncategorized(res []string) []string {
+	res = append(res, core.GetRuleName(&LintIgnoreUnused{}))
 
 	return res
 }
@@ -231,6 +416,7 @@ func addBasic(res []string) []string {
 	res = append(res, core.GetRuleName(&EnumPascalCase{}))
 	res = append(res, core.GetRuleName(&EnumValueUpperSnakeCase{}))
 	res = append(res, core.GetRuleName(&FieldLowerSnakeCase{}))
+	res = append(res, core.GetRuleName(&FieldNoDescriptor{}))
 	res = append(res, core.GetRuleName(&ImportNoPublic{}))
 	res = append(res, core.GetRuleName(&ImportNoWeak{}))
 	res = append(res, core.GetRuleName(&ImportUsed{}))
@@ -246,18 +432,22 @@ func addBasic(res []string) []string {
 	res = append(res, core.GetRuleName(&PackageSameSwiftPrefix{}))
 	res = append(res, core.GetRuleName(&RPCPascalCase{}))
 	res = append(res, core.GetRuleName(&ServicePascalCase{}))
+	res = append(res, core.GetRuleName(&SyntaxSpecified{}))
+	res = append(res, core.GetRuleName(&Protovalidate{}))
 	return res
 }
 
 func addDefault(res []string) []string {
 	res = append(res, core.GetRuleName(&EnumValuePrefix{}))
 	res = append(res, core.GetRuleName(&EnumZeroValueSuffix{}))
+	res = append(res, core.GetRuleName(&FieldNotRequired{}))
 	res = append(res, core.GetRuleName(&FileLowerSnakeCase{}))
 	res = append(res, core.GetRuleName(&RPCRequestResponseUnique{}))
 	res = append(res, core.GetRuleName(&RPCRequestStandardName{}))
 	res = append(res, core.GetRuleName(&RPCResponseStandardName{}))
 	res = append(res, core.GetRuleName(&PackageVersionSuffix{}))
 	res = append(res, core.GetRuleName(&ServiceSuffix{}))
+	res = append(res, core.GetRuleName(&StablePackageNoImportUnstable{}))
 	return res
 }
 
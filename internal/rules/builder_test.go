@@ -32,6 +32,12 @@ func TestAllGroups(t *testing.T) {
 		}
 	})
 
+	t.Run("protovalidate is not minimal", func(t *testing.T) {
+		// enabling it in MINIMAL would turn it on for every existing MINIMAL user
+		require.NotContains(t, groups[0].Rules, "PROTOVALIDATE")
+		require.Contains(t, groups[1].Rules, "PROTOVALIDATE")
+	})
+
 	t.Run("all rule names are unique", func(t *testing.T) {
 		seen := make(map[string]string)
 		for _, g := range groups {
@@ -70,6 +76,29 @@ func TestAllLintUseValues(t *testing.T) {
 	require.Equal(t, len(values), len(uniqueStrings(values)))
 }
 
+func TestAllRules_Documented(t *testing.T) {
+	allRules := rules.AllRules()
+	require.NotEmpty(t, allRules)
+
+	for _, info := range allRules {
+		require.NotEmpty(t, info.Doc.Description, info.Name)
+		require.NotEmpty(t, info.Doc.Bad, info.Name)
+		require.NotEmpty(t, info.Doc.Good, info.Name)
+		require.NotEqual(t, info.Doc.Bad, info.Doc.Good, info.Name)
+	}
+}
+
+func TestRuleByName(t *testing.T) {
+	info, err := rules.RuleByName("SERVICE_SUFFIX")
+	require.NoError(t, err)
+	require.Equal(t, "SERVICE_SUFFIX", info.Name)
+	require.Equal(t, []string{"DEFAULT"}, info.Groups)
+	require.Equal(t, []string{"lint.service_suffix"}, info.Doc.Options)
+
+	_, err = rules.RuleByName("UNKNOWN_RULE")
+	require.ErrorIs(t, err, core.ErrInvalidRule)
+}
+
 func uniqueStrings(values []string) []string {
 	set := make(map[string]struct{}, len(values))
 	for _, value := range values {
@@ -180,3 +209,120 @@ func TestNew_ExplicitSuffixValuesPreserved(t *testing.T) {
 	// Verify rules are created without error; explicit values should be used.
 	require.NotEmpty(t, lintRules)
 }
+
+func TestSeverity(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		severity    map[string]string
+		plugins     []config.LintPlugin
+		want        map[string]core.Severity
+		wantUnknown []string
+		wantErr     error
+	}{
+		"empty": {
+			want: map[string]core.Severity{},
+		},
+		"rule": {
+			severity: map[string]string{"COMMENT_FIELD": "warning"},
+			want:     map[string]core.Severity{"COMMENT_FIELD": core.SeverityWarning},
+		},
+		"rule_overrides_group": {
+			severity: map[string]string{
+				"COMMENTS":        "info",
+				"COMMENT_SERVICE": "error",
+			},
+			want: map[string]core.Severity{
+				"COMMENT_ENUM":       core.SeverityInfo,
+				"COMMENT_ENUM_VALUE": core.SeverityInfo,
+				"COMMENT_FIELD":      core.SeverityInfo,
+				"COMMENT_MESSAGE":    core.SeverityInfo,
+				"COMMENT_ONEOF":      core.SeverityInfo,
+				"COMMENT_RPC":        core.SeverityInfo,
+				"COMMENT_SERVICE":    core.SeverityError,
+			},
+		},
+		"invalid_level": {
+			severity: map[string]string{"COMMENT_FIELD": "fatal"},
+			wantErr:  core.ErrInvalidSeverity,
+		},
+		"unknown_rule": {
+			severity: map[string]string{"COMMENT_FILED": "warning"},
+			wantErr:  core.ErrInvalidRule,
+		},
+		"unknown_group": {
+			severity: map[string]string{"COMMENT": "warning"},
+			wantErr:  core.ErrInvalidRule,
+		},
+		"plugin_rule": {
+			severity: map[string]string{
+				"ACME_FIELD_PREFIX": "warning",
+				"COMMENT_FIELD":     "info",
+			},
+			plugins: []config.LintPlugin{{Path: "easyp-lint-acme"}},
+			want: map[string]core.Severity{
+				"ACME_FIELD_PREFIX": core.SeverityWarning,
+				"COMMENT_FIELD":     core.SeverityInfo,
+			},
+			wantUnknown: []string{"ACME_FIELD_PREFIX"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, unknown, err := rules.Severity(config.LintConfig{Severity: tc.severity, Plugins: tc.plugins})
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantUnknown, unknown)
+		})
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.LintConfig{
+		Use:    []string{"MINIMAL", "SERVICE_SUFFIX", "COMMENT_FIELD"},
+		Except: []string{"PACKAGE_DEFINED"},
+		Overrides: []config.LintOverride{
+			{
+				Path:          "legacy/**",
+				Use:           []string{"COMMENTS"},
+				Except:        []string{"COMMENT_FIELD", "PACKAGE_SAME_DIRECTORY"},
+				ServiceSuffix: "API",
+			},
+		},
+	}
+
+	base, _, err := rules.New(cfg)
+	require.NoError(t, err)
+
+	got, err := rules.Overrides(cfg, base)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "legacy/**", got[0].Path)
+
+	names := make([]string, 0, len(got[0].Rules))
+	for _, rule := range got[0].Rules {
+		names = append(names, core.GetRuleName(rule))
+	}
+	require.Equal(t, []string{
+		"DIRECTORY_SAME_PACKAGE",
+		"PACKAGE_DIRECTORY_MATCH",
+		"PACKAGE_NO_IMPORT_CYCLE",
+		"SERVICE_SUFFIX",
+		"COMMENT_ENUM",
+		"COMMENT_ENUM_VALUE",
+		"COMMENT_MESSAGE",
+		"COMMENT_ONEOF",
+		"COMMENT_RPC",
+		"COMMENT_SERVICE",
+	}, names)
+
+	require.Contains(t, got[0].Rules, core.Rule(&rules.ServiceSuffix{Suffix: "API"}))
+	// cross-file rules are shared with base rules
+	require.Same(t, base[0], got[0].Rules[0])
+	require.Same(t, base[3], got[0].Rules[2])
+}
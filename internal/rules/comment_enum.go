@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentEnum)(nil)
+var _ core.DocumentedRule = (*CommentEnum)(nil)
 
 // CommentEnum this rule checks that enums have non-empty comments.
 type CommentEnum struct{}
@@ -14,20 +14,42 @@ func (c *CommentEnum) Message() string {
 	return "enum comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentEnum) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that enum has a comment.",
+		Bad: `syntax = "proto3";
+
+enum Foo {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+// Foo enum for bar and baz logic
+enum Foo {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentEnum) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, enum := range protoInfo.Info.ProtoBody.Enums {
 		if len(enum.Comments) == 0 {
-			res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName, enum.Comments)
+			res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName)
 		}
 	}
 
 	for _, msg := range protoInfo.Info.ProtoBody.Messages {
 		for _, enum := range msg.MessageBody.Enums {
 			if len(enum.Comments) == 0 {
-				res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName, enum.Comments)
+				res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName)
 			}
 		}
 	}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentEnumValue)(nil)
+var _ core.DocumentedRule = (*CommentEnumValue)(nil)
 
 // CommentEnumValue this rule checks that enum values have non-empty comments.
 type CommentEnumValue struct{}
@@ -14,6 +14,29 @@ func (c *CommentEnumValue) Message() string {
 	return "enum value comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentEnumValue) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that enum values have a comment.",
+		Bad: `syntax = "proto3";
+
+enum Foo {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+enum Foo {
+    // BAR value for bar logic
+    BAR = 0;
+    // BAZ value for baz logic
+    BAZ = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentEnumValue) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -25,8 +48,7 @@ func (c *CommentEnumValue) Validate(protoInfo core.ProtoInfo) ([]core.Issue, err
 					res,
 					c,
 					field.Meta.Pos,
-					field.Ident,
-					field.Comments)
+					field.Ident)
 			}
 		}
 	}
@@ -39,8 +61,7 @@ func (c *CommentEnumValue) Validate(protoInfo core.ProtoInfo) ([]core.Issue, err
 						res,
 						c,
 						field.Meta.Pos,
-						field.Ident,
-						field.Comments)
+						field.Ident)
 				}
 			}
 		}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentField)(nil)
+var _ core.DocumentedRule = (*CommentField)(nil)
 
 // CommentField this rule checks that fields have non-empty comments.
 type CommentField struct{}
@@ -14,6 +14,29 @@ func (c *CommentField) Message() string {
 	return "field comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentField) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all message fields have a comment.",
+		Bad: `syntax = "proto3";
+
+message Foo {
+    string bar = 1;
+    string baz = 2;
+}
+`,
+		Good: `syntax = "proto3";
+
+message Foo {
+    // bar field for bar logic
+    string bar = 1;
+    // baz field for baz logic
+    string baz = 2;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentField) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -21,7 +44,7 @@ func (c *CommentField) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error)
 	for _, message := range protoInfo.Info.ProtoBody.Messages {
 		for _, field := range message.MessageBody.Fields {
 			if len(field.Comments) == 0 {
-				res = core.AppendIssue(res, c, field.Meta.Pos, field.FieldName, field.Comments)
+				res = core.AppendIssue(res, c, field.Meta.Pos, field.FieldName)
 			}
 		}
 	}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentMessage)(nil)
+var _ core.DocumentedRule = (*CommentMessage)(nil)
 
 // CommentMessage this rule checks that messages have non-empty comments.
 type CommentMessage struct{}
@@ -14,13 +14,37 @@ func (c *CommentMessage) Message() string {
 	return "message comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentMessage) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that message has a comment.",
+		Bad: `syntax = "proto3";
+
+message Foo {
+    string bar = 1;
+    string baz = 2;
+}
+`,
+		Good: `syntax = "proto3";
+
+// Foo message for bar and baz logic
+message Foo {
+    // bar field for bar logic
+    string bar = 1;
+    // baz field for baz logic
+    string baz = 2;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentMessage) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, message := range protoInfo.Info.ProtoBody.Messages {
 		if len(message.Comments) == 0 {
-			res = core.AppendIssue(res, c, message.Meta.Pos, message.MessageName, message.Comments)
+			res = core.AppendIssue(res, c, message.Meta.Pos, message.MessageName)
 		}
 	}
 
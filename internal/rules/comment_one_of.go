@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentOneof)(nil)
+var _ core.DocumentedRule = (*CommentOneof)(nil)
 
 // CommentOneof this rule checks that oneofs have non-empty comments.
 type CommentOneof struct{}
@@ -14,6 +14,32 @@ func (c *CommentOneof) Message() string {
 	return "oneof comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentOneof) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that oneof has a comment.",
+		Bad: `syntax = "proto3";
+
+message Foo {
+    oneof bar {
+        string baz = 1;
+        string qux = 2;
+    }
+}
+`,
+		Good: `syntax = "proto3";
+
+message Foo {
+    // bar oneof for baz and qux logic
+    oneof bar {
+        string baz = 1;
+        string qux = 2;
+    }
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentOneof) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -21,7 +47,7 @@ func (c *CommentOneof) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error)
 	for _, msg := range protoInfo.Info.ProtoBody.Messages {
 		for _, oneof := range msg.MessageBody.Oneofs {
 			if len(oneof.Comments) == 0 {
-				res = core.AppendIssue(res, c, oneof.Meta.Pos, oneof.OneofName, oneof.Comments)
+				res = core.AppendIssue(res, c, oneof.Meta.Pos, oneof.OneofName)
 			}
 		}
 	}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentRPC)(nil)
+var _ core.DocumentedRule = (*CommentRPC)(nil)
 
 // CommentRPC this rule checks that RPCs have non-empty comments.
 type CommentRPC struct{}
@@ -14,6 +14,29 @@ func (c *CommentRPC) Message() string {
 	return "rpc comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentRPC) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that rpc has a comment.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc Bar (BarRequest) returns (BarResponse) {}
+    rpc Baz (BazRequest) returns (BazResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service Foo {
+    // Bar rpc for bar logic
+    rpc Bar (BarRequest) returns (BarResponse) {}
+    // Baz rpc for baz logic
+    rpc Baz (BazRequest) returns (BazResponse) {}
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentRPC) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -21,7 +44,7 @@ func (c *CommentRPC) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
 			if len(rpc.Comments) == 0 {
-				res = core.AppendIssue(res, c, rpc.Meta.Pos, rpc.RPCName, rpc.Comments)
+				res = core.AppendIssue(res, c, rpc.Meta.Pos, rpc.RPCName)
 			}
 		}
 	}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*CommentService)(nil)
+var _ core.DocumentedRule = (*CommentService)(nil)
 
 // CommentService this rule checks that services have non-empty comments.
 type CommentService struct{}
@@ -14,13 +14,34 @@ func (c *CommentService) Message() string {
 	return "service comments must not be empty"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *CommentService) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that service has a comment.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc Bar (BarRequest) returns (BarResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+// Foo service for bar logic
+service Foo {
+    // Bar rpc for bar logic
+    rpc Bar (BarRequest) returns (BarResponse) {}
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *CommentService) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		if len(service.Comments) == 0 {
-			res = core.AppendIssue(res, c, service.Meta.Pos, service.ServiceName, service.Comments)
+			res = core.AppendIssue(res, c, service.Meta.Pos, service.ServiceName)
 		}
 	}
 
@@ -6,7 +6,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*DirectorySamePackage)(nil)
+var _ core.CrossFileRule = (*DirectorySamePackage)(nil)
+var _ core.DocumentedRule = (*DirectorySamePackage)(nil)
 
 // DirectorySamePackage this rule checks that all files in a given directory are in the same package.
 type DirectorySamePackage struct {
@@ -20,11 +21,41 @@ func (d *DirectorySamePackage) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (d *DirectorySamePackage) CrossFile() {}
+
 // Message implements lint.Rule.
 func (d *DirectorySamePackage) Message() string {
 	return "all files in the same directory must have the same package name"
 }
 
+// Doc implements core.DocumentedRule.
+func (d *DirectorySamePackage) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files in a given directory are in the same package.",
+		Bad: `// File: dir/foo.proto
+
+syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string bar = 1;
+}
+`,
+		Good: `// File: dir/foo/foo.proto
+
+syntax = "proto3";
+
+package dir.foo;
+
+message Foo {
+    string bar = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (d *DirectorySamePackage) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	d.lazyInit()
@@ -38,7 +69,7 @@ func (d *DirectorySamePackage) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 		}
 
 		if d.cache[directory] != pack.Name {
-			res = core.AppendIssue(res, d, pack.Meta.Pos, pack.Name, pack.Comments)
+			res = core.AppendIssue(res, d, pack.Meta.Pos, pack.Name)
 		}
 	}
 
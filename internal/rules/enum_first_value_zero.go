@@ -1,14 +1,20 @@
 package rules
 
 import (
+	"strconv"
+
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*EnumFirstValueZero)(nil)
+var _ core.DocumentedRule = (*EnumFirstValueZero)(nil)
 
 // EnumFirstValueZero this rule enforces that the first enum value is the zero value,
 // which is a proto3 requirement on build,
-// but isn't required in proto2 on build. The rule enforces that the requirement is also followed in proto2.
+// but isn't required in proto2 and for closed enums in editions on build.
+// The rule enforces that the requirement is also followed there.
 type EnumFirstValueZero struct{}
 
 // Message implements lint.Rule.
@@ -16,22 +22,91 @@ func (c *EnumFirstValueZero) Message() string {
 	return "enum first value must be zero"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *EnumFirstValueZero) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that the first value of an enum is zero.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+enum Foo {
+    BAR = 1;
+    BAZ = 2;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+enum Foo {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *EnumFirstValueZero) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	if protoInfo.Descriptor != nil {
+		var res []core.Issue
+		res = c.validateDescriptorEnums(res, protoInfo.Descriptor.Enums())
+		res = c.validateDescriptorMessages(res, protoInfo.Descriptor.Messages())
+
+		return res, nil
+	}
+
 	var res []core.Issue
-	for _, enum := range protoInfo.Info.ProtoBody.Enums {
-		if val := enum.EnumBody.EnumFields[0]; val.Number != "0" {
-			res = core.AppendIssue(res, c, val.Meta.Pos, val.Number, val.Comments)
+	res = c.validateEnums(res, protoInfo.Info.ProtoBody.Enums)
+	res = c.validateMessages(res, protoInfo.Info.ProtoBody.Messages)
+
+	return res, nil
+}
+
+func (c *EnumFirstValueZero) validateDescriptorMessages(res []core.Issue, messages protoreflect.MessageDescriptors) []core.Issue {
+	for i := 0; i < messages.Len(); i++ {
+		res = c.validateDescriptorEnums(res, messages.Get(i).Enums())
+		res = c.validateDescriptorMessages(res, messages.Get(i).Messages())
+	}
+
+	return res
+}
+
+func (c *EnumFirstValueZero) validateDescriptorEnums(res []core.Issue, enums protoreflect.EnumDescriptors) []core.Issue {
+	for i := 0; i < enums.Len(); i++ {
+		values := enums.Get(i).Values()
+		if values.Len() == 0 {
+			continue
+		}
+
+		if val := values.Get(0); val.Number() != 0 {
+			res = core.AppendDescriptorIssue(res, c, val, strconv.Itoa(int(val.Number())))
 		}
 	}
 
-	for _, msg := range protoInfo.Info.ProtoBody.Messages {
-		for _, enum := range msg.MessageBody.Enums {
-			if val := enum.EnumBody.EnumFields[0]; val.Number != "0" {
-				res = core.AppendIssue(res, c, val.Meta.Pos, val.Number, val.Comments)
-			}
+	return res
+}
+
+func (c *EnumFirstValueZero) validateMessages(res []core.Issue, messages []*unordered.Message) []core.Issue {
+	for _, msg := range messages {
+		res = c.validateEnums(res, msg.MessageBody.Enums)
+		res = c.validateMessages(res, msg.MessageBody.Messages)
+	}
+
+	return res
+}
+
+func (c *EnumFirstValueZero) validateEnums(res []core.Issue, enums []*unordered.Enum) []core.Issue {
+	for _, enum := range enums {
+		if len(enum.EnumBody.EnumFields) == 0 {
+			continue
+		}
+
+		if val := enum.EnumBody.EnumFields[0]; val.Number != "0" {
+			res = core.AppendIssue(res, c, val.Meta.Pos, val.Number)
 		}
 	}
 
-	return res, nil
+	return res
 }
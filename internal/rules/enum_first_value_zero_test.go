@@ -61,6 +61,36 @@ func TestEnumFirstValueZero_Validate(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"invalid_closed": {
+			fileName: enumClosed,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   124,
+					Line:     9,
+					Column:   5,
+				},
+				SourceName: "1",
+				Message:    "enum first value must be zero",
+				RuleName:   "ENUM_FIRST_VALUE_ZERO",
+			},
+			wantErr: nil,
+		},
+		"invalid_deeply_nested": {
+			fileName: enumNested,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   105,
+					Line:     8,
+					Column:   7,
+				},
+				SourceName: "1",
+				Message:    "enum first value must be zero",
+				RuleName:   "ENUM_FIRST_VALUE_ZERO",
+			},
+			wantErr: nil,
+		},
 		"valid": {
 			fileName: validAuthProto,
 			wantErr:  nil,
@@ -4,6 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
+var _ core.DocumentedRule = (*EnumNoAllowAlias)(nil)
+
 // EnumNoAllowAlias this rule checks that enums are PascalCase.
 type EnumNoAllowAlias struct{}
 
@@ -12,13 +14,39 @@ func (e *EnumNoAllowAlias) Message() string {
 	return "enum must not allow alias"
 }
 
+// Doc implements core.DocumentedRule.
+func (e *EnumNoAllowAlias) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that the `allow_alias` option is not set to `true` in an enum.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+enum Foo {
+    option allow_alias = true;
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+enum Foo {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (e *EnumNoAllowAlias) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 	for _, enum := range protoInfo.Info.ProtoBody.Enums {
 		for _, opt := range enum.EnumBody.Options {
 			if opt.OptionName == "allow_alias" {
-				res = core.AppendIssue(res, e, enum.Meta.Pos, enum.EnumName, enum.Comments)
+				res = core.AppendIssue(res, e, enum.Meta.Pos, enum.EnumName)
 			}
 		}
 	}
@@ -27,7 +55,7 @@ func (e *EnumNoAllowAlias) Validate(protoInfo core.ProtoInfo) ([]core.Issue, err
 		for _, enum := range msg.MessageBody.Enums {
 			for _, opt := range enum.EnumBody.Options {
 				if opt.OptionName == "allow_alias" {
-					res = core.AppendIssue(res, e, enum.Meta.Pos, enum.EnumName, enum.Comments)
+					res = core.AppendIssue(res, e, enum.Meta.Pos, enum.EnumName)
 				}
 			}
 		}
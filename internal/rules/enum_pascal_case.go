@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*EnumPascalCase)(nil)
+var _ core.DocumentedRule = (*EnumPascalCase)(nil)
 
 // EnumPascalCase this rule checks that enums are PascalCase.
 type EnumPascalCase struct{}
@@ -16,20 +16,45 @@ func (c *EnumPascalCase) Message() string {
 	return "enum name must be in PascalCase"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *EnumPascalCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that enum names are in PascalCase.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+enum foo_bar {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+enum FooBar {
+    BAR = 0;
+    BAZ = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *EnumPascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 	pascalCase := regexp.MustCompile("^[A-Z][a-z]+(?:[A-Z][a-z]+)*$")
 	for _, enum := range protoInfo.Info.ProtoBody.Enums {
 		if !pascalCase.MatchString(enum.EnumName) {
-			res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName, enum.Comments)
+			res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName)
 		}
 	}
 
 	for _, msg := range protoInfo.Info.ProtoBody.Messages {
 		for _, enum := range msg.MessageBody.Enums {
 			if !pascalCase.MatchString(enum.EnumName) {
-				res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName, enum.Comments)
+				res = core.AppendIssue(res, c, enum.Meta.Pos, enum.EnumName)
 			}
 		}
 	}
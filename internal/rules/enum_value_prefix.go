@@ -7,7 +7,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*EnumValuePrefix)(nil)
+var _ core.DocumentedRule = (*EnumValuePrefix)(nil)
 
 // EnumValuePrefix this rule requires that all enum value names are prefixed with the enum name.
 type EnumValuePrefix struct {
@@ -18,6 +18,25 @@ func (e *EnumValuePrefix) Message() string {
 	return "enum value prefix is not valid"
 }
 
+// Doc implements core.DocumentedRule.
+func (e *EnumValuePrefix) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all enum values are prefixed with the enum name.",
+		Bad: `syntax = "proto3";
+
+enum Foo {
+    BAR = 0;
+}
+`,
+		Good: `syntax = "proto3";
+
+enum Foo {
+    FOO_BAR = 0;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (e *EnumValuePrefix) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -32,7 +51,6 @@ func (e *EnumValuePrefix) Validate(protoInfo core.ProtoInfo) ([]core.Issue, erro
 					e,
 					enumValue.Meta.Pos,
 					enumValue.Ident,
-					enumValue.Comments,
 				)
 			}
 		}
@@ -49,7 +67,6 @@ func (e *EnumValuePrefix) Validate(protoInfo core.ProtoInfo) ([]core.Issue, erro
 						e,
 						enumValue.Meta.Pos,
 						enumValue.Ident,
-						enumValue.Comments,
 					)
 				}
 			}
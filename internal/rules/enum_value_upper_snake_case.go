@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*EnumValueUpperSnakeCase)(nil)
+var _ core.DocumentedRule = (*EnumValueUpperSnakeCase)(nil)
 
 // EnumValueUpperSnakeCase this rule checks that enum values are UPPER_SNAKE_CASE.
 type EnumValueUpperSnakeCase struct{}
@@ -16,6 +16,31 @@ func (c *EnumValueUpperSnakeCase) Message() string {
 	return "enum value must be in UPPER_SNAKE_CASE"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *EnumValueUpperSnakeCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that enum values are in UPPER_SNAKE_CASE.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+enum Foo {
+    barName = 0;
+    bazName = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+enum Foo {
+    BAR_NAME = 0;
+    BAZ_NAME = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *EnumValueUpperSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -23,7 +48,7 @@ func (c *EnumValueUpperSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Iss
 	for _, enum := range protoInfo.Info.ProtoBody.Enums {
 		for _, field := range enum.EnumBody.EnumFields {
 			if !upperSnakeCase.MatchString(field.Ident) {
-				res = core.AppendIssue(res, c, field.Meta.Pos, field.Ident, field.Comments)
+				res = core.AppendIssue(res, c, field.Meta.Pos, field.Ident)
 			}
 		}
 	}
@@ -32,7 +57,7 @@ func (c *EnumValueUpperSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Iss
 		for _, enum := range msg.MessageBody.Enums {
 			for _, field := range enum.EnumBody.EnumFields {
 				if !upperSnakeCase.MatchString(field.Ident) {
-					res = core.AppendIssue(res, c, field.Meta.Pos, field.Ident, field.Comments)
+					res = core.AppendIssue(res, c, field.Meta.Pos, field.Ident)
 				}
 			}
 		}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*EnumZeroValueSuffix)(nil)
+var _ core.DocumentedRule = (*EnumZeroValueSuffix)(nil)
 
 // EnumZeroValueSuffix this rule requires that all enum values have a zero value with a defined suffix.
 // By default, it verifies that the zero value of all enums ends in _UNSPECIFIED, but the suffix is configurable.
@@ -17,6 +17,26 @@ func (e *EnumZeroValueSuffix) Message() string {
 	return "enum zero value suffix is not valid"
 }
 
+// Doc implements core.DocumentedRule.
+func (e *EnumZeroValueSuffix) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all enum zero values are suffixed with `_NONE` or your custom suffix.",
+		Bad: `syntax = "proto3";
+
+enum Foo {
+    BAR = 0;
+}
+`,
+		Good: `syntax = "proto3";
+
+enum Foo {
+    FOO_BAR_NONE = 0;
+}
+`,
+		Options: []string{"lint.enum_zero_value_suffix"},
+	}
+}
+
 // Validate implements lint.Rule.
 func (e *EnumZeroValueSuffix) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -29,7 +49,6 @@ func (e *EnumZeroValueSuffix) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 				e,
 				zeroValue.Meta.Pos,
 				zeroValue.Ident,
-				zeroValue.Comments,
 			)
 		}
 	}
@@ -43,7 +62,6 @@ func (e *EnumZeroValueSuffix) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 					e,
 					zeroValue.Meta.Pos,
 					zeroValue.Ident,
-					zeroValue.Comments,
 				)
 			}
 		}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+var _ core.DocumentedRule = (*FieldNoDescriptor)(nil)
+
+// FieldNoDescriptor this rule enforces that field names are not any capitalization of "descriptor"
+// with any number of prefix or suffix underscores.
+// Such fields conflict with the generated Descriptor method in several languages.
+type FieldNoDescriptor struct{}
+
+// Message implements lint.Rule.
+func (f *FieldNoDescriptor) Message() string {
+	return `field name should not be "descriptor"`
+}
+
+// Doc implements core.DocumentedRule.
+func (f *FieldNoDescriptor) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that field names are not any capitalization of `descriptor` with any number of prefix or suffix underscores, e.g. `descriptor`, `Descriptor` or `_descriptor_`. Such fields conflict with the generated code in several languages.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string descriptor = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string file_descriptor = 1;
+}
+`,
+	}
+}
+
+// Validate implements lint.Rule.
+func (f *FieldNoDescriptor) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	return f.validateMessages(nil, protoInfo.Info.ProtoBody.Messages), nil
+}
+
+func (f *FieldNoDescriptor) validateMessages(res []core.Issue, messages []*unordered.Message) []core.Issue {
+	for _, message := range messages {
+		for _, field := range message.MessageBody.Fields {
+			if isDescriptorName(field.FieldName) {
+				res = core.AppendIssue(res, f, field.Meta.Pos, field.FieldName)
+			}
+		}
+
+		for _, field := range message.MessageBody.Maps {
+			if isDescriptorName(field.MapName) {
+				res = core.AppendIssue(res, f, field.Meta.Pos, field.MapName)
+			}
+		}
+
+		for _, oneof := range message.MessageBody.Oneofs {
+			for _, field := range oneof.OneofFields {
+				if isDescriptorName(field.FieldName) {
+					res = core.AppendIssue(res, f, field.Meta.Pos, field.FieldName)
+				}
+			}
+		}
+
+		res = f.validateMessages(res, message.MessageBody.Messages)
+	}
+
+	return res
+}
+
+func isDescriptorName(name string) bool {
+	return strings.ToLower(strings.Trim(name, "_")) == "descriptor"
+}
@@ -0,0 +1,86 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+func TestFieldNoDescriptor_Message(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	const expMessage = `field name should not be "descriptor"`
+
+	rule := rules.FieldNoDescriptor{}
+	message := rule.Message()
+
+	assert.Equal(expMessage, message)
+}
+
+func TestFieldNoDescriptor_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fileName   string
+		wantIssues *core.Issue
+		wantErr    error
+	}{
+		"invalid_oneof": {
+			fileName: fieldNoDescriptor,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   153,
+					Line:     13,
+					Column:   5,
+				},
+				SourceName: "descriptor",
+				Message:    `field name should not be "descriptor"`,
+				RuleName:   "FIELD_NO_DESCRIPTOR",
+			},
+		},
+		"invalid_nested": {
+			fileName: fieldNoDescriptor,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   104,
+					Line:     9,
+					Column:   5,
+				},
+				SourceName: "_Descriptor_",
+				Message:    `field name should not be "descriptor"`,
+				RuleName:   "FIELD_NO_DESCRIPTOR",
+			},
+		},
+		"valid": {
+			fileName: validAuthProto,
+			wantErr:  nil,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, protos := start(t)
+
+			rule := rules.FieldNoDescriptor{}
+			issues, err := rule.Validate(protos[tc.fileName])
+			r.ErrorIs(err, tc.wantErr)
+			switch {
+			case tc.wantIssues != nil:
+				r.Contains(issues, *tc.wantIssues)
+			case len(issues) > 0:
+				r.Empty(issues)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+var _ core.DocumentedRule = (*FieldNotRequired)(nil)
+
+// FieldNotRequired this rule outlaws required fields: proto2 `required` label
+// and `features.field_presence = LEGACY_REQUIRED` in editions.
+// Required fields can never be removed or made optional without breaking the wire compatibility.
+type FieldNotRequired struct{}
+
+// Message implements lint.Rule.
+func (f *FieldNotRequired) Message() string {
+	return "field should not be required"
+}
+
+// Doc implements core.DocumentedRule.
+func (f *FieldNotRequired) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that fields are not required: neither with the `proto2` `required` label nor with `features.field_presence = LEGACY_REQUIRED` in editions. A required field can never be removed or made optional without breaking existing clients.",
+		Bad: `syntax = "proto2";
+
+package foo;
+
+message Foo {
+    required string id = 1;
+}
+`,
+		Good: `syntax = "proto2";
+
+package foo;
+
+message Foo {
+    optional string id = 1;
+}
+`,
+	}
+}
+
+// Validate implements lint.Rule.
+func (f *FieldNotRequired) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	if protoInfo.Descriptor != nil {
+		var res []core.Issue
+		res = f.validateDescriptorFields(res, protoInfo.Descriptor.Extensions())
+		res = f.validateDescriptorMessages(res, protoInfo.Descriptor.Messages())
+
+		return res, nil
+	}
+
+	var res []core.Issue
+	for _, extend := range protoInfo.Info.ProtoBody.Extends {
+		res = f.validateFields(res, extend.ExtendBody.Fields)
+	}
+	res = f.validateMessages(res, protoInfo.Info.ProtoBody.Messages)
+
+	return res, nil
+}
+
+func (f *FieldNotRequired) validateDescriptorMessages(res []core.Issue, messages protoreflect.MessageDescriptors) []core.Issue {
+	for i := range messages.Len() {
+		message := messages.Get(i)
+
+		res = f.validateDescriptorFields(res, message.Fields())
+		res = f.validateDescriptorFields(res, message.Extensions())
+		res = f.validateDescriptorMessages(res, message.Messages())
+	}
+
+	return res
+}
+
+func (f *FieldNotRequired) validateDescriptorFields(res []core.Issue, fields fieldDescriptors) []core.Issue {
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Cardinality() == protoreflect.Required {
+			res = core.AppendDescriptorIssue(res, f, field, string(field.Name()))
+		}
+	}
+
+	return res
+}
+
+func (f *FieldNotRequired) validateMessages(res []core.Issue, messages []*unordered.Message) []core.Issue {
+	for _, message := range messages {
+		res = f.validateFields(res, message.MessageBody.Fields)
+		for _, extend := range message.MessageBody.Extends {
+			res = f.validateFields(res, extractFields(extend.ExtendBody))
+		}
+		res = f.validateMessages(res, message.MessageBody.Messages)
+	}
+
+	return res
+}
+
+func (f *FieldNotRequired) validateFields(res []core.Issue, fields []*parser.Field) []core.Issue {
+	for _, field := range fields {
+		if field.IsRequired || isLegacyRequired(field.FieldOptions) {
+			res = core.AppendIssue(res, f, field.Meta.Pos, field.FieldName)
+		}
+	}
+
+	return res
+}
+
+func isLegacyRequired(options []*parser.FieldOption) bool {
+	for _, option := range options {
+		if option.OptionName == "features.field_presence" && option.Constant == "LEGACY_REQUIRED" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func extractFields(body []parser.Visitee) []*parser.Field {
+	var fields []*parser.Field
+	for _, element := range body {
+		if field, ok := element.(*parser.Field); ok {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
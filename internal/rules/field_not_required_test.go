@@ -0,0 +1,86 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+func TestFieldNotRequired_Message(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	const expMessage = "field should not be required"
+
+	rule := rules.FieldNotRequired{}
+	message := rule.Message()
+
+	assert.Equal(expMessage, message)
+}
+
+func TestFieldNotRequired_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fileName   string
+		wantIssues *core.Issue
+		wantErr    error
+	}{
+		"invalid_proto2": {
+			fileName: fieldRequiredProto2,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   112,
+					Line:     9,
+					Column:   5,
+				},
+				SourceName: "id",
+				Message:    "field should not be required",
+				RuleName:   "FIELD_NOT_REQUIRED",
+			},
+		},
+		"invalid_edition": {
+			fileName: fieldRequiredEdition,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   64,
+					Line:     6,
+					Column:   3,
+				},
+				SourceName: "id",
+				Message:    "field should not be required",
+				RuleName:   "FIELD_NOT_REQUIRED",
+			},
+		},
+		"valid": {
+			fileName: validAuthProto,
+			wantErr:  nil,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, protos := start(t)
+
+			rule := rules.FieldNotRequired{}
+			issues, err := rule.Validate(protos[tc.fileName])
+			r.ErrorIs(err, tc.wantErr)
+			switch {
+			case tc.wantIssues != nil:
+				r.Contains(issues, *tc.wantIssues)
+			case len(issues) > 0:
+				r.Empty(issues)
+			}
+		})
+	}
+}
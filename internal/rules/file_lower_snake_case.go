@@ -9,7 +9,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*FileLowerSnakeCase)(nil)
+var _ core.DocumentedRule = (*FileLowerSnakeCase)(nil)
 
 // FileLowerSnakeCase this rule says that all .proto files must be named as lower_snake_case.proto.
 // This is the widely accepted standard.
@@ -21,6 +21,21 @@ func (f *FileLowerSnakeCase) Message() string {
 	return "file name should be lower_snake_case.proto"
 }
 
+// Doc implements core.DocumentedRule.
+func (f *FileLowerSnakeCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files are in lower_snake_case.",
+		Bad: `// File: bar/FooBaz.proto
+
+syntax = "proto3";
+`,
+		Good: `// File: bar/foo_baz.proto
+
+syntax = "proto3";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (f *FileLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -32,7 +47,7 @@ func (f *FileLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, e
 			Offset:   0,
 			Line:     0,
 			Column:   0,
-		}, protoInfo.Path, nil)
+		}, protoInfo.Path)
 	}
 
 	return res, nil
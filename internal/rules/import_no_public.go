@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*ImportNoPublic)(nil)
+var _ core.DocumentedRule = (*ImportNoPublic)(nil)
 
 // ImportNoPublic this rule outlaws declaring imports as public.
 // If you didn't know that was possible, forget what you just learned in this sentence.
@@ -17,13 +17,32 @@ func (i *ImportNoPublic) Message() string {
 	return "import should not be public"
 }
 
+// Doc implements core.DocumentedRule.
+func (i *ImportNoPublic) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that no public imports are used in a proto file.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+import public "bar.proto";
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+import "bar.proto";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (i *ImportNoPublic) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, imp := range protoInfo.Info.ProtoBody.Imports {
 		if imp.Modifier == parser.ImportModifierPublic {
-			res = core.AppendIssue(res, i, imp.Meta.Pos, imp.Location, imp.Comments)
+			res = core.AppendIssue(res, i, imp.Meta.Pos, imp.Location)
 		}
 	}
 
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*ImportNoWeak)(nil)
+var _ core.DocumentedRule = (*ImportNoWeak)(nil)
 
 // ImportNoWeak similar to the IMPORT_NO_PUBLIC rule, this rule outlaws declaring imports as weak.
 // If you didn't know that was possible, forget what you just learned in this sentence.
@@ -17,13 +17,32 @@ func (i *ImportNoWeak) Message() string {
 	return "import should not be weak"
 }
 
+// Doc implements core.DocumentedRule.
+func (i *ImportNoWeak) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that no weak imports are used in a proto file.",
+		Bad: `syntax = "proto2";
+
+package foo;
+
+import weak "bar.proto";
+`,
+		Good: `syntax = "proto2";
+
+package foo;
+
+import "bar.proto";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (i *ImportNoWeak) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, imp := range protoInfo.Info.ProtoBody.Imports {
 		if imp.Modifier == parser.ImportModifierWeak {
-			res = core.AppendIssue(res, i, imp.Meta.Pos, imp.Location, imp.Comments)
+			res = core.AppendIssue(res, i, imp.Meta.Pos, imp.Location)
 		}
 	}
 
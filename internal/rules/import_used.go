@@ -3,17 +3,26 @@ package rules
 import (
 	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*ImportUsed)(nil)
+var _ core.DocumentedRule = (*ImportUsed)(nil)
 
 // ImportUsed this rule checks that all the imports declared across your Protobuf files are actually used.
-type ImportUsed struct {
-	instrParser  core.InstructionParser
-	isImportUsed map[core.ImportPath]bool
-	pkgToImport  map[core.PackageName][]core.ImportPath
+// Types and options are resolved by compiled descriptor if it's available,
+// otherwise imports are checked by names of used types.
+// Files are validated concurrently, so state of the check is kept in importUsage, not in the rule.
+type ImportUsed struct{}
+
+// importUsage collects usage of imports of a single proto file by names of used types.
+type importUsage struct {
+	checkingProto core.ProtoInfo
+	instrParser   core.InstructionParser
+	isImportUsed  map[core.ImportPath]bool
+	pkgToImport   map[core.PackageName][]core.ImportPath
 }
 
 // Message implements lint.Rule.
@@ -21,19 +30,48 @@ func (i *ImportUsed) Message() string {
 	return "import is not used"
 }
 
+// Doc implements core.DocumentedRule.
+func (i *ImportUsed) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all imports are used in a proto file.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+import "bar.proto";
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+import "bar.proto";
+
+message Foo {
+    bar.Bar bar = 1;
+}
+`,
+	}
+}
+
 // Validate implements core.Rule.
 func (i *ImportUsed) Validate(checkingProto core.ProtoInfo) ([]core.Issue, error) {
+	if checkingProto.Descriptor != nil {
+		return i.validateDescriptor(checkingProto)
+	}
+
 	var res []core.Issue
 
-	i.instrParser = core.InstructionParser{
-		SourcePkgName: core.GetPackageName(checkingProto.Info),
+	usage := &importUsage{
+		checkingProto: checkingProto,
+		instrParser: core.InstructionParser{
+			SourcePkgName: core.GetPackageName(checkingProto.Info),
+		},
+		// collects flags if import was used
+		isImportUsed: make(map[core.ImportPath]bool),
+		// collects pkg name -> import path
+		pkgToImport: make(map[core.PackageName][]core.ImportPath),
 	}
 
-	// collects flags if import was used
-	i.isImportUsed = make(map[core.ImportPath]bool)
-
-	// collects pkg name -> import path
-	i.pkgToImport = make(map[core.PackageName][]core.ImportPath)
 	for importPath, proto := range checkingProto.ProtoFilesFromImport {
 		pkgName := core.GetPackageName(proto)
 		if pkgName == "" {
@@ -41,26 +79,26 @@ func (i *ImportUsed) Validate(checkingProto core.ProtoInfo) ([]core.Issue, error
 			continue
 		}
 
-		i.pkgToImport[pkgName] = append(i.pkgToImport[pkgName], importPath)
+		usage.pkgToImport[pkgName] = append(usage.pkgToImport[pkgName], importPath)
 	}
 
 	// collects info about import in linted proto file
 	importInfo := make(map[core.ImportPath]*parser.Import)
 	for _, imp := range checkingProto.Info.ProtoBody.Imports {
 		importPath := core.ConvertImportPath(imp.Location)
-		i.isImportUsed[importPath] = false
+		usage.isImportUsed[importPath] = false
 		importInfo[importPath] = imp
 	}
 
 	// look for import used
-	i.checkInServices(checkingProto.Info.ProtoBody.Services, checkingProto)
-	i.checkInMessages(checkingProto.Info.ProtoBody.Messages, checkingProto)
-	i.checkInExtends(checkingProto.Info.ProtoBody.Extends, checkingProto)
-	i.checkInOptions(checkingProto.Info.ProtoBody.Options, checkingProto)
+	usage.checkInServices(checkingProto.Info.ProtoBody.Services)
+	usage.checkInMessages(checkingProto.Info.ProtoBody.Messages)
+	usage.checkInExtends(checkingProto.Info.ProtoBody.Extends)
+	usage.checkInOptions(checkingProto.Info.ProtoBody.Options)
 
-	for imp, used := range i.isImportUsed {
+	for imp, used := range usage.isImportUsed {
 		if !used {
-			res = core.AppendIssue(res, i, importInfo[imp].Meta.Pos, importInfo[imp].Location, importInfo[imp].Comments)
+			res = core.AppendIssue(res, i, importInfo[imp].Meta.Pos, importInfo[imp].Location)
 		}
 	}
 
@@ -68,70 +106,70 @@ func (i *ImportUsed) Validate(checkingProto core.ProtoInfo) ([]core.Issue, error
 }
 
 // checkIsImportUsed check if passed import is used in proto file
-func (i *ImportUsed) checkIsImportUsed(key string, checkingProto core.ProtoInfo) {
-	instruction := i.instrParser.Parse(key)
-	for _, importPath := range i.pkgToImport[instruction.PkgName] {
-		proto := checkingProto.ProtoFilesFromImport[importPath]
+func (u *importUsage) checkIsImportUsed(key string) {
+	instruction := u.instrParser.Parse(key)
+	for _, importPath := range u.pkgToImport[instruction.PkgName] {
+		proto := u.checkingProto.ProtoFilesFromImport[importPath]
 		exist := existInProto(instruction.Instruction, proto)
 
 		if exist {
-			if _, ok := i.isImportUsed[importPath]; ok {
-				i.isImportUsed[importPath] = true
+			if _, ok := u.isImportUsed[importPath]; ok {
+				u.isImportUsed[importPath] = true
 			}
 		}
 	}
 }
 
 // check used imports in services
-func (i *ImportUsed) checkInServices(services []*unordered.Service, checkingProto core.ProtoInfo) {
+func (u *importUsage) checkInServices(services []*unordered.Service) {
 	for _, service := range services {
 		for _, rpc := range service.ServiceBody.RPCs {
 			// look for in request
-			i.checkIsImportUsed(rpc.RPCRequest.MessageType, checkingProto)
+			u.checkIsImportUsed(rpc.RPCRequest.MessageType)
 
 			// look for in response
-			i.checkIsImportUsed(rpc.RPCResponse.MessageType, checkingProto)
+			u.checkIsImportUsed(rpc.RPCResponse.MessageType)
 
 			// look for in options
 			for _, rpcOption := range rpc.Options {
-				i.checkIsImportUsed(rpcOption.OptionName, checkingProto)
+				u.checkIsImportUsed(rpcOption.OptionName)
 			}
 		}
 	}
 }
 
 // check used imports in messages
-func (i *ImportUsed) checkInMessages(messages []*unordered.Message, checkingProto core.ProtoInfo) {
+func (u *importUsage) checkInMessages(messages []*unordered.Message) {
 	for _, msg := range messages {
-		i.checkInMessages(msg.MessageBody.Messages, checkingProto)
+		u.checkInMessages(msg.MessageBody.Messages)
 
 		for _, field := range msg.MessageBody.Fields {
 			// look for field's type in imported files
-			i.checkIsImportUsed(field.Type, checkingProto)
+			u.checkIsImportUsed(field.Type)
 
 			// look for field's options in imported files
 			for _, fieldOption := range field.FieldOptions {
-				i.checkIsImportUsed(fieldOption.OptionName, checkingProto)
+				u.checkIsImportUsed(fieldOption.OptionName)
 			}
 		}
 
 		for _, oneOf := range msg.MessageBody.Oneofs {
 			for _, field := range oneOf.OneofFields {
-				i.checkIsImportUsed(field.Type, checkingProto)
+				u.checkIsImportUsed(field.Type)
 			}
 		}
 	}
 }
 
-func (i *ImportUsed) checkInExtends(extends []*unordered.Extend, checkingProto core.ProtoInfo) {
+func (u *importUsage) checkInExtends(extends []*unordered.Extend) {
 	for _, extend := range extends {
-		i.checkIsImportUsed(extend.MessageType, checkingProto)
+		u.checkIsImportUsed(extend.MessageType)
 	}
 }
 
-func (i *ImportUsed) checkInOptions(options []*parser.Option, checkingProto core.ProtoInfo) {
+func (u *importUsage) checkInOptions(options []*parser.Option) {
 	for _, option := range options {
-		i.checkIsImportUsed(option.OptionName, checkingProto)
+		u.checkIsImportUsed(option.OptionName)
 	}
 }
 
@@ -163,3 +201,244 @@ func existInProto(key string, proto *unordered.Proto) bool {
 
 	return false
 }
+
+// validateDescriptor checks imports by compiled descriptor:
+// every used type, extendee and custom option is resolved to the file where it is declared.
+func (i *ImportUsed) validateDescriptor(checkingProto core.ProtoInfo) ([]core.Issue, error) {
+	var res []core.Issue
+
+	fd := checkingProto.Descriptor
+
+	usedFiles := make(map[string]bool)
+	collectUsedFilesInFile(fd, usedFiles)
+
+	imports := fd.Imports()
+	for idx := range imports.Len() {
+		imp := imports.Get(idx)
+		if imp.IsPublic {
+			// public imports are re-exported to dependents of the file
+			continue
+		}
+
+		if isImportUsed(imp.FileDescriptor, usedFiles) {
+			continue
+		}
+
+		importInfo, ok := findImport(checkingProto, imp.Path())
+		if !ok {
+			continue
+		}
+
+		res = core.AppendIssue(res, i, importInfo.Meta.Pos, importInfo.Location)
+	}
+
+	return res, nil
+}
+
+// isImportUsed check if something is used from imported file or from files it imports publicly.
+func isImportUsed(imp protoreflect.FileDescriptor, usedFiles map[string]bool) bool {
+	if usedFiles[imp.Path()] {
+		return true
+	}
+
+	imports := imp.Imports()
+	for idx := range imports.Len() {
+		publicImport := imports.Get(idx)
+		if publicImport.IsPublic && isImportUsed(publicImport.FileDescriptor, usedFiles) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func collectUsedFilesInFile(fd protoreflect.FileDescriptor, usedFiles map[string]bool) {
+	collectUsedFilesInOptions(fd, fd.Options(), usedFiles)
+	collectUsedFilesInMessages(fd, fd.Messages(), usedFiles)
+	collectUsedFilesInEnums(fd, fd.Enums(), usedFiles)
+	collectUsedFilesInFields(fd, fd.Extensions(), usedFiles)
+
+	services := fd.Services()
+	for idx := range services.Len() {
+		service := services.Get(idx)
+		collectUsedFilesInOptions(fd, service.Options(), usedFiles)
+
+		methods := service.Methods()
+		for j := range methods.Len() {
+			method := methods.Get(j)
+			usedFiles[method.Input().ParentFile().Path()] = true
+			usedFiles[method.Output().ParentFile().Path()] = true
+			collectUsedFilesInOptions(fd, method.Options(), usedFiles)
+		}
+	}
+}
+
+func collectUsedFilesInMessages(fd protoreflect.FileDescriptor, messages protoreflect.MessageDescriptors, usedFiles map[string]bool) {
+	for idx := range messages.Len() {
+		message := messages.Get(idx)
+		if message.IsMapEntry() {
+			collectUsedFilesInFields(fd, message.Fields(), usedFiles)
+			continue
+		}
+
+		collectUsedFilesInOptions(fd, message.Options(), usedFiles)
+		collectUsedFilesInFields(fd, message.Fields(), usedFiles)
+		collectUsedFilesInFields(fd, message.Extensions(), usedFiles)
+		collectUsedFilesInMessages(fd, message.Messages(), usedFiles)
+		collectUsedFilesInEnums(fd, message.Enums(), usedFiles)
+
+		oneofs := message.Oneofs()
+		for j := range oneofs.Len() {
+			collectUsedFilesInOptions(fd, oneofs.Get(j).Options(), usedFiles)
+		}
+	}
+}
+
+func collectUsedFilesInEnums(fd protoreflect.FileDescriptor, enums protoreflect.EnumDescriptors, usedFiles map[string]bool) {
+	for idx := range enums.Len() {
+		enum := enums.Get(idx)
+		collectUsedFilesInOptions(fd, enum.Options(), usedFiles)
+
+		values := enum.Values()
+		for j := range values.Len() {
+			collectUsedFilesInOptions(fd, values.Get(j).Options(), usedFiles)
+		}
+	}
+}
+
+// fieldDescriptors is implemented by both protoreflect.FieldDescriptors and protoreflect.ExtensionDescriptors.
+type fieldDescriptors interface {
+	Len() int
+	Get(i int) protoreflect.FieldDescriptor
+}
+
+func collectUsedFilesInFields(fd protoreflect.FileDescriptor, fields fieldDescriptors, usedFiles map[string]bool) {
+	for idx := range fields.Len() {
+		field := fields.Get(idx)
+		collectUsedFilesInOptions(fd, field.Options(), usedFiles)
+
+		if field.IsExtension() {
+			usedFiles[field.ContainingMessage().ParentFile().Path()] = true
+		}
+
+		switch {
+		case field.Message() != nil:
+			usedFiles[field.Message().ParentFile().Path()] = true
+		case field.Enum() != nil:
+			usedFiles[field.Enum().ParentFile().Path()] = true
+		}
+	}
+}
+
+// collectUsedFilesInOptions marks files which declare custom options set in passed options message.
+// Options which extensions were not resolved during unmarshalling are kept as unknown fields,
+// they are looked up among extensions visible to the file by their numbers.
+func collectUsedFilesInOptions(fd protoreflect.FileDescriptor, options protoreflect.ProtoMessage, usedFiles map[string]bool) {
+	if options == nil {
+		return
+	}
+
+	msg := options.ProtoReflect()
+	if !msg.IsValid() {
+		return
+	}
+
+	msg.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if field.IsExtension() {
+			usedFiles[field.ParentFile().Path()] = true
+		}
+		return true
+	})
+
+	unknown := msg.GetUnknown()
+	for len(unknown) > 0 {
+		number, _, n := protowire.ConsumeField(unknown)
+		if n < 0 {
+			return
+		}
+		unknown = unknown[n:]
+
+		if ext := findExtension(fd, msg.Descriptor().FullName(), number); ext != nil {
+			usedFiles[ext.ParentFile().Path()] = true
+		}
+	}
+}
+
+// findExtension look for extension of passed message with passed number in file's imports.
+func findExtension(
+	fd protoreflect.FileDescriptor, extendee protoreflect.FullName, number protowire.Number,
+) protoreflect.ExtensionDescriptor {
+	imports := fd.Imports()
+	for idx := range imports.Len() {
+		imp := imports.Get(idx)
+		if ext := findExtensionInFile(imp.FileDescriptor, extendee, number); ext != nil {
+			return ext
+		}
+	}
+
+	return nil
+}
+
+func findExtensionInFile(
+	fd protoreflect.FileDescriptor, extendee protoreflect.FullName, number protowire.Number,
+) protoreflect.ExtensionDescriptor {
+	if ext := findExtensionInFields(fd.Extensions(), extendee, number); ext != nil {
+		return ext
+	}
+
+	var search func(messages protoreflect.MessageDescriptors) protoreflect.ExtensionDescriptor
+	search = func(messages protoreflect.MessageDescriptors) protoreflect.ExtensionDescriptor {
+		for idx := range messages.Len() {
+			message := messages.Get(idx)
+			if ext := findExtensionInFields(message.Extensions(), extendee, number); ext != nil {
+				return ext
+			}
+			if ext := search(message.Messages()); ext != nil {
+				return ext
+			}
+		}
+		return nil
+	}
+
+	if ext := search(fd.Messages()); ext != nil {
+		return ext
+	}
+
+	// extensions from public imports are visible as well
+	imports := fd.Imports()
+	for idx := range imports.Len() {
+		imp := imports.Get(idx)
+		if !imp.IsPublic {
+			continue
+		}
+		if ext := findExtensionInFile(imp.FileDescriptor, extendee, number); ext != nil {
+			return ext
+		}
+	}
+
+	return nil
+}
+
+func findExtensionInFields(
+	extensions protoreflect.ExtensionDescriptors, extendee protoreflect.FullName, number protowire.Number,
+) protoreflect.ExtensionDescriptor {
+	for idx := range extensions.Len() {
+		ext := extensions.Get(idx)
+		if ext.ContainingMessage().FullName() == extendee && ext.Number() == number {
+			return ext
+		}
+	}
+
+	return nil
+}
+
+// findImport look for import statement in parsed proto file by its path.
+func findImport(checkingProto core.ProtoInfo, path string) (*parser.Import, bool) {
+	for _, imp := range checkingProto.Info.ProtoBody.Imports {
+		if string(core.ConvertImportPath(imp.Location)) == path {
+			return imp, true
+		}
+	}
+
+	return nil, false
+}
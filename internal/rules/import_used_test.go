@@ -50,6 +50,25 @@ func TestImportUsed_Validate(t *testing.T) {
 			fileName: importUsed,
 			wantErr:  nil,
 		},
+		"invalid_compiled": {
+			fileName: importNotUsedCompiled,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   88,
+					Line:     6,
+					Column:   1,
+				},
+				SourceName: `"import_used/compiled/options.proto"`,
+				Message:    "import is not used",
+				RuleName:   "IMPORT_USED",
+			},
+			wantErr: nil,
+		},
+		"valid_compiled": {
+			fileName: importUsedCompiled,
+			wantErr:  nil,
+		},
 	}
 
 	for name, tc := range tests {
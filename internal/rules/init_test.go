@@ -1,13 +1,21 @@
 package rules_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/wellknownimports"
 	"github.com/stretchr/testify/require"
 	"github.com/yoheimuta/go-protoparser/v4"
 	"github.com/yoheimuta/go-protoparser/v4/interpret/unordered"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/easyp-tech/easyp/internal/core"
 )
@@ -25,8 +33,23 @@ const (
 	validAuthProto2          = `./../../testdata/api/session/v1/events.proto`
 	importUsed               = "./../../testdata/import_used/used.proto"
 	importNotUsed            = "./../../testdata/import_used/not_used.proto"
-	noLintBufComment         = "./../../testdata/no_lint/no_lint_buf_comment.proto"
-	noLintEasypComment       = "./../../testdata/no_lint/no_lint_easyp_comment.proto"
+	importUsedCompiled       = "./../../testdata/import_used/compiled/used.proto"
+	importNotUsedCompiled    = "./../../testdata/import_used/compiled/not_used.proto"
+	rpcNotUniqueCompiled     = "./../../testdata/rpc_request_response_unique/not_unique.proto"
+	rpcEmpty                 = "./../../testdata/rpc_request_response_unique/empty.proto"
+	rpcSame                  = "./../../testdata/rpc_request_response_unique/same.proto"
+	protoValidateValid       = "./../../testdata/protovalidate/valid.proto"
+	protoValidateInvalid     = "./../../testdata/protovalidate/invalid.proto"
+	syntaxNotSpecified       = "./../../testdata/syntax_specified/not_specified.proto"
+	syntaxEdition            = "./../../testdata/syntax_specified/edition.proto"
+	fieldNoDescriptor        = "./../../testdata/field_no_descriptor/invalid.proto"
+	fieldRequiredProto2      = "./../../testdata/field_not_required/proto2.proto"
+	fieldRequiredEdition     = "./../../testdata/field_not_required/edition.proto"
+	enumClosed               = "./../../testdata/enum_first_value_zero/closed.proto"
+	enumNested               = "./../../testdata/enum_first_value_zero/nested.proto"
+	stablePackage            = "./../../testdata/stable_package/foo/v1/foo.proto"
+	importCycleA             = "./../../testdata/import_cycle/a/v1/a.proto"
+	importCycleC             = "./../../testdata/import_cycle/b/v1/c.proto"
 )
 
 func start(t testing.TB) (*require.Assertions, map[string]core.ProtoInfo) {
@@ -47,8 +70,23 @@ func start(t testing.TB) (*require.Assertions, map[string]core.ProtoInfo) {
 		validAuthProto2:          parseFile(t, assert, validAuthProto2),
 		importUsed:               parseFile(t, assert, importUsed),
 		importNotUsed:            parseFile(t, assert, importNotUsed),
-		noLintBufComment:         parseFile(t, assert, noLintBufComment),
-		noLintEasypComment:       parseFile(t, assert, noLintEasypComment),
+		importUsedCompiled:       parseFile(t, assert, importUsedCompiled),
+		importNotUsedCompiled:    parseFile(t, assert, importNotUsedCompiled),
+		rpcNotUniqueCompiled:     parseFile(t, assert, rpcNotUniqueCompiled),
+		rpcEmpty:                 parseFile(t, assert, rpcEmpty),
+		rpcSame:                  parseFile(t, assert, rpcSame),
+		protoValidateValid:       parseFile(t, assert, protoValidateValid),
+		protoValidateInvalid:     parseFile(t, assert, protoValidateInvalid),
+		syntaxNotSpecified:       parseFile(t, assert, syntaxNotSpecified),
+		syntaxEdition:            parseFile(t, assert, syntaxEdition),
+		fieldNoDescriptor:        parseFile(t, assert, fieldNoDescriptor),
+		fieldRequiredProto2:      parseFile(t, assert, fieldRequiredProto2),
+		fieldRequiredEdition:     parseFile(t, assert, fieldRequiredEdition),
+		enumClosed:               parseFile(t, assert, enumClosed),
+		enumNested:               parseFile(t, assert, enumNested),
+		stablePackage:            parseFile(t, assert, stablePackage),
+		importCycleA:             parseFile(t, assert, importCycleA),
+		importCycleC:             parseFile(t, assert, importCycleC),
 	}
 
 	return assert, protos
@@ -94,5 +132,47 @@ func parseFile(t testing.TB, assert *require.Assertions, path string) core.Proto
 		Path:                 path,
 		Info:                 res,
 		ProtoFilesFromImport: protoFilesFromImport,
+		Descriptor:           compileFile(path),
 	}
 }
+
+// compiledFiles caches compiled test files, descriptors are immutable so they can be shared between tests.
+var compiledFiles sync.Map
+
+// compileFile compiles test proto file, returns nil if it can't be compiled
+// the same way as core does.
+func compileFile(path string) protoreflect.FileDescriptor {
+	if cached, ok := compiledFiles.Load(path); ok {
+		fd, _ := cached.(protoreflect.FileDescriptor)
+		return fd
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.CompositeResolver{
+			// protovalidate rules are taken from the generated package instead of vendoring validate.proto,
+			// its dependencies must be taken from the same registry to avoid duplicated symbols.
+			protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+				fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+				if err != nil {
+					return protocompile.SearchResult{}, err
+				}
+
+				return protocompile.SearchResult{Desc: fd}, nil
+			}),
+			wellknownimports.WithStandardImports(&protocompile.SourceResolver{
+				ImportPaths: []string{"./../../testdata"},
+			}),
+		},
+		SourceInfoMode: protocompile.SourceInfoStandard,
+		RetainASTs:     true,
+	}
+
+	files, err := compiler.Compile(context.Background(), strings.TrimPrefix(path, "./../../testdata/"))
+	var fd protoreflect.FileDescriptor
+	if err == nil {
+		fd = files[0]
+	}
+	compiledFiles.Store(path, fd)
+
+	return fd
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+var _ core.IgnoreDirectivesRule = (*LintIgnoreUnused)(nil)
+var _ core.DocumentedRule = (*LintIgnoreUnused)(nil)
+
+// LintIgnoreUnused this rule reports comment ignore directives (nolint, buf:lint:ignore, easyp:lint:ignore-file)
+// which don't disable any issue of enabled rules, so stale directives can be removed.
+// With comment_ignores_require_justification it also reports directives without justification.
+// The rule is not a part of any group and has to be enabled explicitly.
+type LintIgnoreUnused struct{}
+
+// IgnoreDirectives implements core.IgnoreDirectivesRule.
+func (l *LintIgnoreUnused) IgnoreDirectives() {}
+
+// Message implements lint.Rule.
+func (l *LintIgnoreUnused) Message() string {
+	return "ignore directive is unused"
+}
+
+// Doc implements core.DocumentedRule.
+func (l *LintIgnoreUnused) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that comment ignore directives (`nolint`, `buf:lint:ignore`, `easyp:lint:ignore-file`) ignore at least one issue of an enabled rule, so stale directives don't hide future issues. With `comment_ignores_require_justification: true` it also reports directives without justification after `--`.\n\nDirectives for rules which are not enabled for the file and for rules of lint plugins are not reported. The rule requires `allow_comment_ignores: true`.",
+		Bad: `syntax = "proto3";
+
+package foo.v1;
+
+// nolint:FIELD_LOWER_SNAKE_CASE -- legacy field names
+message User {
+  string user_name = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo.v1;
+
+message User {
+  string user_name = 1;
+}
+`,
+		Options: []string{
+			"lint.allow_comment_ignores",
+			"lint.comment_ignores_require_justification",
+		},
+	}
+}
+
+// Validate implements lint.Rule.
+// Directives are checked by core after all other rules are run.
+func (l *LintIgnoreUnused) Validate(_ core.ProtoInfo) ([]core.Issue, error) {
+	return nil, nil
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*FieldLowerSnakeCase)(nil)
+var _ core.DocumentedRule = (*FieldLowerSnakeCase)(nil)
 
 // FieldLowerSnakeCase this rule checks that field names are lower_snake_case.
 type FieldLowerSnakeCase struct{}
@@ -16,6 +16,29 @@ func (c *FieldLowerSnakeCase) Message() string {
 	return "message field should be lower_snake_case"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *FieldLowerSnakeCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that field names of messages are in lower_snake_case.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string BarName = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string bar_name = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *FieldLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -24,7 +47,7 @@ func (c *FieldLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 	for _, message := range protoInfo.Info.ProtoBody.Messages {
 		for _, field := range message.MessageBody.Fields {
 			if !lowerSnakeCase.MatchString(field.FieldName) {
-				res = core.AppendIssue(res, c, field.Meta.Pos, field.FieldName, field.Comments)
+				res = core.AppendIssue(res, c, field.Meta.Pos, field.FieldName)
 			}
 		}
 	}
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*MessagePascalCase)(nil)
+var _ core.DocumentedRule = (*MessagePascalCase)(nil)
 
 // MessagePascalCase this rule checks that messages are PascalCase.
 type MessagePascalCase struct{}
@@ -16,6 +16,29 @@ func (c *MessagePascalCase) Message() string {
 	return "message name should be PascalCase"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *MessagePascalCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that message names are in PascalCase.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+message foo_bar {
+    string bar_name = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+message FooBar {
+    string bar_name = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *MessagePascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -23,7 +46,7 @@ func (c *MessagePascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, er
 	pascalCase := regexp.MustCompile("^[A-Z][a-zA-Z0-9]+(?:[A-Z][a-zA-Z0-9]+)*$")
 	for _, message := range protoInfo.Info.ProtoBody.Messages {
 		if !pascalCase.MatchString(message.MessageName) {
-			res = core.AppendIssue(res, c, message.Meta.Pos, message.MessageName, message.Comments)
+			res = core.AppendIssue(res, c, message.Meta.Pos, message.MessageName)
 		}
 	}
 
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*OneofLowerSnakeCase)(nil)
+var _ core.DocumentedRule = (*OneofLowerSnakeCase)(nil)
 
 // OneofLowerSnakeCase this rule checks that oneof names are lower_snake_case.
 type OneofLowerSnakeCase struct{}
@@ -16,6 +16,33 @@ func (c *OneofLowerSnakeCase) Message() string {
 	return "oneof name should be lower_snake_case"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *OneofLowerSnakeCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that oneof names are in lower_snake_case.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    oneof BarName {
+        string bar_name = 1;
+    }
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    oneof bar_name {
+        string bar_name = 1;
+    }
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *OneofLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -23,7 +50,7 @@ func (c *OneofLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 	for _, message := range protoInfo.Info.ProtoBody.Messages {
 		for _, oneof := range message.MessageBody.Oneofs {
 			if !lowerSnakeCase.MatchString(oneof.OneofName) {
-				res = core.AppendIssue(res, c, oneof.Meta.Pos, oneof.OneofName, oneof.Comments)
+				res = core.AppendIssue(res, c, oneof.Meta.Pos, oneof.OneofName)
 			}
 		}
 	}
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageDefined)(nil)
+var _ core.DocumentedRule = (*PackageDefined)(nil)
 
 // PackageDefined this rule checks that all files have a package declaration.
 type PackageDefined struct{}
@@ -16,6 +16,27 @@ func (p *PackageDefined) Message() string {
 	return "package should be defined"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageDefined) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files have a package declaration.",
+		Bad: `syntax = "proto3";
+
+message Foo {
+    string bar = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string bar = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageDefined) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -23,7 +44,7 @@ func (p *PackageDefined) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error
 	if len(protoInfo.Info.ProtoBody.Packages) == 0 {
 		res = core.AppendIssue(res, p, meta.Position{
 			Filename: protoInfo.Path,
-		}, protoInfo.Path, nil)
+		}, protoInfo.Path)
 	}
 
 	return res, nil
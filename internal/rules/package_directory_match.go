@@ -7,7 +7,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageDirectoryMatch)(nil)
+var _ core.DocumentedRule = (*PackageDirectoryMatch)(nil)
 
 // PackageDirectoryMatch is a rule for checking consistency of directory and package names.
 type PackageDirectoryMatch struct {
@@ -19,6 +19,33 @@ func (d *PackageDirectoryMatch) Message() string {
 	return "package does not match directory path"
 }
 
+// Doc implements core.DocumentedRule.
+func (d *PackageDirectoryMatch) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files are in a directory that matches their package name.",
+		Bad: `// File: bar/foo.proto
+
+syntax = "proto3";
+
+package foo;
+
+message Foo {
+    string bar = 1;
+}
+`,
+		Good: `// File: bar/foo.proto
+
+syntax = "proto3";
+
+package bar;
+
+message Foo {
+    string bar = 1;
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (d *PackageDirectoryMatch) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -29,7 +56,7 @@ func (d *PackageDirectoryMatch) Validate(protoInfo core.ProtoInfo) ([]core.Issue
 
 	for _, pkgInfo := range protoInfo.Info.ProtoBody.Packages {
 		if pkgInfo.Name != expectedPackage {
-			res = core.AppendIssue(res, d, pkgInfo.Meta.Pos, protoInfo.Path, pkgInfo.Comments)
+			res = core.AppendIssue(res, d, pkgInfo.Meta.Pos, protoInfo.Path)
 		}
 	}
 
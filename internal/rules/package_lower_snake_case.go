@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageLowerSnakeCase)(nil)
+var _ core.DocumentedRule = (*PackageLowerSnakeCase)(nil)
 
 // PackageLowerSnakeCase his rule checks that packages are lower_snake_case.
 type PackageLowerSnakeCase struct{}
@@ -16,13 +16,30 @@ func (c *PackageLowerSnakeCase) Message() string {
 	return "package name should be lower_snake_case"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *PackageLowerSnakeCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that package names are in lower_snake_case.",
+		Bad: `// File: bar/foo.proto
+syntax = "proto3";
+
+package FooBar;
+`,
+		Good: `// File: bar/foo.proto
+syntax = "proto3";
+
+package foo_bar;
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *PackageLowerSnakeCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 	lowerSnakeCase := regexp.MustCompile("^[a-z]+([_|[.][a-z0-9]+)*$")
 	for _, pack := range protoInfo.Info.ProtoBody.Packages {
 		if !lowerSnakeCase.MatchString(pack.Name) {
-			res = core.AppendIssue(res, c, pack.Meta.Pos, pack.Name, pack.Comments)
+			res = core.AppendIssue(res, c, pack.Meta.Pos, pack.Name)
 		}
 	}
 
@@ -1,13 +1,22 @@
 package rules
 
 import (
+	"slices"
+
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageNoImportCycle)(nil)
+var _ core.CrossFileRule = (*PackageNoImportCycle)(nil)
+var _ core.DocumentedRule = (*PackageNoImportCycle)(nil)
 
-// PackageNoImportCycle this is an extra uncategorized rule that detects package import cycles.
+// PackageNoImportCycle this rule detects package import cycles.
 // The Protobuf compiler outlaws circular file imports, but it's still possible to introduce package cycles, such as these:
+//
+//	foo/v1/a.proto (package foo.v1) imports bar/v1/b.proto (package bar.v1),
+//	bar/v1/c.proto (package bar.v1) imports foo/v1/d.proto (package foo.v1).
+//
+// Imports are collected while files are checked, so the cycle is reported on the import
+// of the file which closes it.
 type PackageNoImportCycle struct {
 	// cache is a map of package name to a slice of package names that it imports
 	cache map[string][]string
@@ -19,13 +28,91 @@ func (p *PackageNoImportCycle) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageNoImportCycle) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageNoImportCycle) Message() string {
 	return "package should not have import cycles"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageNoImportCycle) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that packages don't import each other. The compiler outlaws circular file imports, but it's still possible to introduce a cycle between packages through different files. The issue is reported on the import which closes the cycle.",
+		Bad: `// File: foo/v1/a.proto
+
+syntax = "proto3";
+
+package foo.v1;
+
+import "bar/v1/b.proto";
+
+// File: bar/v1/c.proto
+
+syntax = "proto3";
+
+package bar.v1;
+
+import "foo/v1/d.proto";
+`,
+		Good: `
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageNoImportCycle) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
-	panic("implement me")
+
+	var res []core.Issue
+
+	if len(protoInfo.Info.ProtoBody.Packages) == 0 {
+		return res, nil
+	}
+	pkg := protoInfo.Info.ProtoBody.Packages[0].Name
+
+	importedPackages := make([]string, len(protoInfo.Info.ProtoBody.Imports))
+	for i, imp := range protoInfo.Info.ProtoBody.Imports {
+		imported, ok := protoInfo.ProtoFilesFromImport[core.ConvertImportPath(imp.Location)]
+		if !ok || len(imported.ProtoBody.Packages) == 0 {
+			continue
+		}
+
+		importedPackages[i] = imported.ProtoBody.Packages[0].Name
+		if importedPackages[i] != pkg && !slices.Contains(p.cache[pkg], importedPackages[i]) {
+			p.cache[pkg] = append(p.cache[pkg], importedPackages[i])
+		}
+	}
+
+	for i, imp := range protoInfo.Info.ProtoBody.Imports {
+		if importedPackages[i] == "" || importedPackages[i] == pkg {
+			continue
+		}
+
+		if p.isReachable(importedPackages[i], pkg, make(map[string]bool)) {
+			res = core.AppendIssue(res, p, imp.Meta.Pos, imp.Location)
+		}
+	}
+
+	return res, nil
+}
+
+// isReachable reports whether package to is imported by package from directly or transitively.
+func (p *PackageNoImportCycle) isReachable(from, to string, visited map[string]bool) bool {
+	if from == to {
+		return true
+	}
+	if visited[from] {
+		return false
+	}
+	visited[from] = true
+
+	for _, next := range p.cache[from] {
+		if p.isReachable(next, to, visited) {
+			return true
+		}
+	}
+
+	return false
 }
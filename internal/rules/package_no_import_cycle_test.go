@@ -0,0 +1,78 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+func TestPackageNoImportCycle_Message(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	const expMessage = "package should not have import cycles"
+
+	rule := rules.PackageNoImportCycle{}
+	message := rule.Message()
+
+	assert.Equal(expMessage, message)
+}
+
+func TestPackageNoImportCycle_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fileNames  []string
+		wantIssues *core.Issue
+		wantErr    error
+	}{
+		"invalid": {
+			fileNames: []string{importCycleA, importCycleC},
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   48,
+					Line:     5,
+					Column:   1,
+				},
+				SourceName: `"import_cycle/a/v1/d.proto"`,
+				Message:    "package should not have import cycles",
+				RuleName:   "PACKAGE_NO_IMPORT_CYCLE",
+			},
+		},
+		"valid": {
+			fileNames: []string{importCycleA, validAuthProto},
+			wantErr:   nil,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, protos := start(t)
+
+			rule := rules.PackageNoImportCycle{}
+
+			var issues []core.Issue
+			for _, fileName := range tc.fileNames {
+				fileIssues, err := rule.Validate(protos[fileName])
+				r.ErrorIs(err, tc.wantErr)
+				issues = append(issues, fileIssues...)
+			}
+
+			switch {
+			case tc.wantIssues != nil:
+				r.Contains(issues, *tc.wantIssues)
+			case len(issues) > 0:
+				r.Empty(issues)
+			}
+		})
+	}
+}
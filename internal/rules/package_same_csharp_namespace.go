@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameCsharpNamespace)(nil)
+var _ core.CrossFileRule = (*PackageSameCsharpNamespace)(nil)
+var _ core.DocumentedRule = (*PackageSameCsharpNamespace)(nil)
 
 // PackageSameCsharpNamespace checks that all files with a given package have the same value for the csharp_namespace option.
 type PackageSameCsharpNamespace struct {
@@ -18,11 +19,53 @@ func (p *PackageSameCsharpNamespace) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSameCsharpNamespace) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSameCsharpNamespace) Message() string {
 	return "different proto files in the same package should have the same csharp_namespace"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSameCsharpNamespace) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same C# namespace.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option csharp_namespace = "Foo.Bar";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option csharp_namespace = "Foo.Baz";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option csharp_namespace = "Foo.Bar";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option csharp_namespace = "Foo.Bar";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSameCsharpNamespace) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSameCsharpNamespace) Validate(protoInfo core.ProtoInfo) ([]core.
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
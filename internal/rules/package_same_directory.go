@@ -6,7 +6,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameDirectory)(nil)
+var _ core.CrossFileRule = (*PackageSameDirectory)(nil)
+var _ core.DocumentedRule = (*PackageSameDirectory)(nil)
 
 // PackageSameDirectory this rule checks that all files with a given package are in the same directory.
 type PackageSameDirectory struct {
@@ -20,11 +21,53 @@ func (d *PackageSameDirectory) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (d *PackageSameDirectory) CrossFile() {}
+
 // Message implements lint.Rule.
 func (d *PackageSameDirectory) Message() string {
 	return "different proto files in the same package should be in the same directory"
 }
 
+// Doc implements core.DocumentedRule.
+func (d *PackageSameDirectory) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same directory.",
+		Bad: `// File: foo/v1/foo.proto
+
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+
+// File: foo/v1beta/bar.proto
+
+syntax = "proto3";
+
+package foo.v1;
+
+message Bar {}
+`,
+		Good: `// File: foo/v1/foo.proto
+
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+
+// File: foo/v1/bar.proto
+
+syntax = "proto3";
+
+package foo.v1;
+
+message Bar {}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (d *PackageSameDirectory) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	d.lazyInit()
@@ -39,7 +82,7 @@ func (d *PackageSameDirectory) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 		}
 
 		if d.cache[packageInfo.Name] != directory {
-			res = core.AppendIssue(res, d, packageInfo.Meta.Pos, packageInfo.Name, packageInfo.Comments)
+			res = core.AppendIssue(res, d, packageInfo.Meta.Pos, packageInfo.Name)
 		}
 	}
 
@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameGoPackage)(nil)
+var _ core.CrossFileRule = (*PackageSameGoPackage)(nil)
+var _ core.DocumentedRule = (*PackageSameGoPackage)(nil)
 
 // PackageSameGoPackage checks that all files with a given package have the same value for the go_package option.
 type PackageSameGoPackage struct {
@@ -18,11 +19,53 @@ func (p *PackageSameGoPackage) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSameGoPackage) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSameGoPackage) Message() string {
 	return "all files in the same package must have the same go_package name"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSameGoPackage) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same Go package.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option go_package = "example.com/foo/bar";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option go_package = "example.com/foo/baz";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option go_package = "example.com/foo/bar";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option go_package = "example.com/foo/bar";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSameGoPackage) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSameGoPackage) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
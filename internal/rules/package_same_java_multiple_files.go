@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameJavaMultipleFiles)(nil)
+var _ core.CrossFileRule = (*PackageSameJavaMultipleFiles)(nil)
+var _ core.DocumentedRule = (*PackageSameJavaMultipleFiles)(nil)
 
 // PackageSameJavaMultipleFiles checks that all files with a given package have the same value for the java_multiple_files option.
 type PackageSameJavaMultipleFiles struct {
@@ -18,11 +19,53 @@ func (p *PackageSameJavaMultipleFiles) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSameJavaMultipleFiles) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSameJavaMultipleFiles) Message() string {
 	return "all files in the same package must have the same java_multiple_files option"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSameJavaMultipleFiles) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same Java package.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.bar";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.foo";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSameJavaMultipleFiles) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSameJavaMultipleFiles) Validate(protoInfo core.ProtoInfo) ([]cor
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameJavaPackage)(nil)
+var _ core.CrossFileRule = (*PackageSameJavaPackage)(nil)
+var _ core.DocumentedRule = (*PackageSameJavaPackage)(nil)
 
 // PackageSameJavaPackage checks that all files with a given package have the same value for the java_package option.
 type PackageSameJavaPackage struct {
@@ -18,11 +19,53 @@ func (p *PackageSameJavaPackage) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSameJavaPackage) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSameJavaPackage) Message() string {
 	return "all files in the same package must have the same java_package option"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSameJavaPackage) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same Java package.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.bar";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option java_package = "com.example.foo";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSameJavaPackage) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSameJavaPackage) Validate(protoInfo core.ProtoInfo) ([]core.Issu
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
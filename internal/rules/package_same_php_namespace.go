@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSamePHPNamespace)(nil)
+var _ core.CrossFileRule = (*PackageSamePHPNamespace)(nil)
+var _ core.DocumentedRule = (*PackageSamePHPNamespace)(nil)
 
 // PackageSamePHPNamespace checks that all files with a given package have the same value for the php_namespace option.
 type PackageSamePHPNamespace struct {
@@ -18,11 +19,53 @@ func (p *PackageSamePHPNamespace) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSamePHPNamespace) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSamePHPNamespace) Message() string {
 	return "all files in the same package must have the same php_namespace option"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSamePHPNamespace) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same PHP namespace.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option php_namespace = "Foo\\Bar";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option php_namespace = "Foo\\Baz";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option php_namespace = "Foo\\Bar";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option php_namespace = "Foo\\Bar";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSamePHPNamespace) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSamePHPNamespace) Validate(protoInfo core.ProtoInfo) ([]core.Iss
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
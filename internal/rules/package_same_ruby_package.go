@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameRubyPackage)(nil)
+var _ core.CrossFileRule = (*PackageSameRubyPackage)(nil)
+var _ core.DocumentedRule = (*PackageSameRubyPackage)(nil)
 
 // PackageSameRubyPackage checks that all files with a given package have the same value for the ruby_package option.
 type PackageSameRubyPackage struct {
@@ -18,11 +19,53 @@ func (p *PackageSameRubyPackage) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSameRubyPackage) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSameRubyPackage) Message() string {
 	return "all files in the same package must have the same ruby_package option"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSameRubyPackage) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same Ruby package.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option ruby_package = "Foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option ruby_package = "Bar";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option ruby_package = "Foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option ruby_package = "Foo";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSameRubyPackage) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSameRubyPackage) Validate(protoInfo core.ProtoInfo) ([]core.Issu
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
@@ -4,7 +4,8 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageSameSwiftPrefix)(nil)
+var _ core.CrossFileRule = (*PackageSameSwiftPrefix)(nil)
+var _ core.DocumentedRule = (*PackageSameSwiftPrefix)(nil)
 
 // PackageSameSwiftPrefix checks that all files with a given package have the same value for the swift_prefix option.
 type PackageSameSwiftPrefix struct {
@@ -18,11 +19,53 @@ func (p *PackageSameSwiftPrefix) lazyInit() {
 	}
 }
 
+// CrossFile implements core.CrossFileRule.
+func (p *PackageSameSwiftPrefix) CrossFile() {}
+
 // Message implements lint.Rule.
 func (p *PackageSameSwiftPrefix) Message() string {
 	return "all files in the same package must have the same swift_prefix option"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageSameSwiftPrefix) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all files with a given package are in the same Swift prefix.",
+		Bad: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option swift_prefix = "Foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option swift_prefix = "Bar";
+`,
+		Good: `// File: pkg/foo.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option swift_prefix = "Foo";
+
+// File: pkg/bar.proto
+
+syntax = "proto3";
+
+package pkg;
+
+option swift_prefix = "Foo";
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (p *PackageSameSwiftPrefix) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	p.lazyInit()
@@ -42,7 +85,7 @@ func (p *PackageSameSwiftPrefix) Validate(protoInfo core.ProtoInfo) ([]core.Issu
 			}
 
 			if p.cache[packageName] != option.Constant {
-				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant, option.Comments)
+				res = core.AppendIssue(res, p, option.Meta.Pos, option.Constant)
 			}
 		}
 	}
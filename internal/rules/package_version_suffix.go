@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*PackageVersionSuffix)(nil)
+var _ core.DocumentedRule = (*PackageVersionSuffix)(nil)
 
 // PackageVersionSuffix this rule enforces that the last component of a package must be a version of the form
 // v\d+, v\d+test.*, v\d+(alpha|beta)\d*, or v\d+p\d+(alpha|beta)\d*, where numbers are >=1.
@@ -17,6 +17,21 @@ func (p *PackageVersionSuffix) Message() string {
 	return "package name should have a version suffix"
 }
 
+// Doc implements core.DocumentedRule.
+func (p *PackageVersionSuffix) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that the package version suffix is `_vX` where `X` is a number of version.",
+		Bad: `syntax = "proto3";
+
+package foo;
+`,
+		Good: `syntax = "proto3";
+
+package foo.v1;
+`,
+	}
+}
+
 var matchVersionSuffix = regexp.MustCompile(`.*v\d+|.*v\d+test.*|.*v\d+(alpha|beta)\d*|.*v\d+p\d+(alpha|beta)\d*$`)
 
 // Validate implements lint.Rule.
@@ -25,7 +40,7 @@ func (p *PackageVersionSuffix) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 
 	for _, pkg := range protoInfo.Info.ProtoBody.Packages {
 		if !matchVersionSuffix.MatchString(pkg.Name) {
-			res = core.AppendIssue(res, p, pkg.Meta.Pos, pkg.Name, pkg.Comments)
+			res = core.AppendIssue(res, p, pkg.Meta.Pos, pkg.Name)
 		}
 	}
 
@@ -1,13 +1,32 @@
 package rules
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	pvcel "buf.build/go/protovalidate/cel"
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*ProtoValidate)(nil)
+var _ core.DocumentedRule = (*Protovalidate)(nil)
 
-// ProtoValidate this rule requires that all protovalidate constraints specified are valid.
-type ProtoValidate struct{}
+// Protovalidate this rule requires that all protovalidate constraints specified are valid.
+type Protovalidate struct{}
+
+// celEnv is shared by all files: building environment with protovalidate library is expensive.
+// Env is safe for concurrent use.
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(cel.Lib(pvcel.NewLibrary()))
+})
 
 //This rule requires that all protovalidate constraints specified are valid.
 //
@@ -68,12 +87,503 @@ type ProtoValidate struct{}
 //If unique is set to true, the field must be a scalar or a wrapper type.
 
 // Validate checks that all protovalidate constraints specified are valid.
-func (p *ProtoValidate) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
-	//TODO implement me
-	panic("implement me")
+// Constraints are read from compiled descriptors, so file is skipped if it could not be compiled.
+func (p *Protovalidate) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	if protoInfo.Descriptor == nil {
+		return nil, nil
+	}
+
+	env, err := celEnv()
+	if err != nil {
+		return nil, fmt.Errorf("cel.NewEnv: %w", err)
+	}
+
+	v := &protoValidateChecker{rule: p, env: env}
+	v.checkMessages(protoInfo.Descriptor.Messages())
+
+	extensions := protoInfo.Descriptor.Extensions()
+	for i := range extensions.Len() {
+		v.checkField(extensions.Get(i))
+	}
+
+	return v.issues, nil
 }
 
 // Message implements lint.Rule.
-func (p *ProtoValidate) Message() string {
-	panic("implement me")
+func (p *Protovalidate) Message() string {
+	return "protovalidate constraints must be valid"
+}
+
+// Doc implements core.DocumentedRule.
+func (p *Protovalidate) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all [protovalidate](https://github.com/bufbuild/protovalidate) constraints specified with `buf.validate.field` and `buf.validate.message` options are valid:\n\n- type specific rules match the field type, e.g. `(buf.validate.field).int32` is set only on `int32` or `google.protobuf.Int32Value` fields;\n- rules permit some value, e.g. `gt` and `lt` are not equal, `len` is not set together with `min_len` or `max_len`;\n- `pattern` is a valid RE2 regular expression;\n- CEL expressions compile and evaluate to `bool` or `string`, and have non-empty unique ids.\n\nThe rule is applied only to files which can be compiled, so `buf/validate/validate.proto` must be available, e.g. as a dependency.",
+		Bad: `syntax = "proto3";
+
+package foo;
+
+import "buf/validate/validate.proto";
+
+message User {
+    string name = 1 [(buf.validate.field).int32.gt = 0];
+    int32 age = 2 [(buf.validate.field).int32 = {
+        gte: 18
+        lte: 18
+    }];
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+import "buf/validate/validate.proto";
+
+message User {
+    string name = 1 [(buf.validate.field).string.min_len = 1];
+    int32 age = 2 [(buf.validate.field).int32.const = 18];
+}
+`,
+	}
+}
+
+// protoValidateChecker collects issues of the single file.
+type protoValidateChecker struct {
+	rule   *Protovalidate
+	env    *cel.Env
+	issues []core.Issue
+}
+
+// ruleTarget is a value which field rules are applied to:
+// a field itself or items, keys and values of repeated and map fields.
+type ruleTarget struct {
+	field protoreflect.FieldDescriptor
+	// owner is the field with the option, it differs from field for map keys and values.
+	owner    protoreflect.FieldDescriptor
+	forItems bool
+	// path is the option name parts which lead to the rules, used for positions in the source file.
+	path []string
+}
+
+func (v *protoValidateChecker) report(d protoreflect.Descriptor, path []string, format string, args ...any) {
+	v.issues = core.AppendIssueWithMessage(
+		v.issues,
+		v.rule,
+		core.OptionPosition(d, path...),
+		string(d.FullName()),
+		v.rule.Message()+": "+fmt.Sprintf(format, args...),
+	)
+}
+
+func (v *protoValidateChecker) checkMessages(messages protoreflect.MessageDescriptors) {
+	for i := range messages.Len() {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+
+		v.checkMessage(message)
+
+		fields := message.Fields()
+		for j := range fields.Len() {
+			v.checkField(fields.Get(j))
+		}
+
+		extensions := message.Extensions()
+		for j := range extensions.Len() {
+			v.checkField(extensions.Get(j))
+		}
+
+		v.checkMessages(message.Messages())
+	}
+}
+
+func (v *protoValidateChecker) checkMessage(message protoreflect.MessageDescriptor) {
+	path := []string{string(validate.E_Message.TypeDescriptor().FullName())}
+
+	rules, err := protovalidate.ResolveMessageRules(message)
+	if err != nil {
+		v.report(message, path, "invalid message rules: %v", err)
+		return
+	}
+	if rules == nil {
+		return
+	}
+
+	for _, oneof := range rules.GetOneof() {
+		if len(oneof.GetFields()) == 0 {
+			v.report(message, append(path, "oneof"), "oneof rule must specify at least one field")
+		}
+
+		seen := make(map[string]bool, len(oneof.GetFields()))
+		for _, name := range oneof.GetFields() {
+			if message.Fields().ByName(protoreflect.Name(name)) == nil {
+				v.report(message, append(path, "oneof"), "field %q specified in oneof rule does not exist", name)
+			}
+			if seen[name] {
+				v.report(message, append(path, "oneof"), "field %q specified in oneof rule more than once", name)
+			}
+			seen[name] = true
+		}
+	}
+
+	if len(rules.GetCel()) == 0 {
+		return
+	}
+
+	env, err := v.env.Extend(
+		cel.Types(dynamicpb.NewMessage(message)),
+		cel.Variable("this", cel.ObjectType(string(message.FullName()))),
+	)
+	if err != nil {
+		v.report(message, path, "failed to build CEL environment: %v", err)
+		return
+	}
+
+	v.checkCEL(message, append(path, "cel"), env, rules.GetCel())
+}
+
+func (v *protoValidateChecker) checkField(field protoreflect.FieldDescriptor) {
+	path := []string{string(validate.E_Field.TypeDescriptor().FullName())}
+
+	rules, err := protovalidate.ResolveFieldRules(field)
+	if err != nil {
+		v.report(field, path, "invalid field rules: %v", err)
+		return
+	}
+	if rules == nil {
+		return
+	}
+
+	if rules.GetRequired() {
+		switch {
+		case field.IsExtension():
+			v.report(field, append(path, "required"), "required must not be set on an extension")
+		case field.ContainingOneof() != nil && !field.ContainingOneof().IsSynthetic():
+			v.report(field, append(path, "required"), "required must not be set on a oneof member")
+		case rules.GetIgnore() != validate.Ignore_IGNORE_UNSPECIFIED:
+			v.report(field, append(path, "required"), "required and ignore must not be set both")
+		}
+	}
+
+	if field.IsExtension() && rules.GetIgnore() != validate.Ignore_IGNORE_UNSPECIFIED {
+		v.report(field, append(path, "ignore"), "ignore must not be set on an extension")
+	}
+
+	v.checkFieldRules(ruleTarget{field: field, owner: field, path: path}, rules)
+}
+
+func (v *protoValidateChecker) checkFieldRules(target ruleTarget, rules *validate.FieldRules) {
+	field, owner := target.field, target.owner
+
+	if rules.GetIgnore() == validate.Ignore_IGNORE_ALWAYS && countSetFields(rules.ProtoReflect()) > 1 {
+		v.report(owner, append(target.path, "ignore"), "ignore IGNORE_ALWAYS must be the only rule if set")
+	}
+
+	if len(rules.GetCel()) != 0 {
+		opts := append(
+			pvcel.RequiredEnvOptions(field),
+			cel.Variable("this", pvcel.ProtoFieldToType(field, false, target.forItems)),
+		)
+
+		env, err := v.env.Extend(opts...)
+		if err != nil {
+			v.report(owner, target.path, "failed to build CEL environment: %v", err)
+		} else {
+			v.checkCEL(owner, append(target.path, "cel"), env, rules.GetCel())
+		}
+	}
+
+	typeField := rules.ProtoReflect().WhichOneof(rules.ProtoReflect().Descriptor().Oneofs().ByName("type"))
+	if typeField == nil {
+		return
+	}
+
+	ruleType := string(typeField.Name())
+	typedRules := rules.ProtoReflect().Get(typeField).Message()
+	path := append(target.path, ruleType)
+
+	if !isRuleTypeCompatible(ruleType, target) {
+		v.report(owner, path, "%s rules cannot be applied to field of type %s", ruleType, targetTypeName(target))
+		return
+	}
+
+	v.checkBounds(owner, path, typedRules)
+
+	switch ruleType {
+	case "string":
+		v.checkStringRules(owner, path, rules.GetString())
+	case "bytes":
+		v.checkBytesRules(owner, path, rules.GetBytes())
+	case "timestamp":
+		v.checkTimestampRules(owner, path, rules.GetTimestamp())
+	case "repeated":
+		repeated := rules.GetRepeated()
+		if repeated.MinItems != nil && repeated.MaxItems != nil && repeated.GetMinItems() > repeated.GetMaxItems() {
+			v.report(owner, append(path, "min_items"), "min_items must not be greater than max_items")
+		}
+		if repeated.GetUnique() && !isScalarOrWrapper(field) {
+			v.report(owner, append(path, "unique"), "unique can only be set on repeated scalar or wrapper type fields")
+		}
+		if repeated.GetItems() != nil {
+			v.checkFieldRules(ruleTarget{field: field, owner: owner, forItems: true, path: append(path, "items")}, repeated.GetItems())
+		}
+	case "map":
+		mapRules := rules.GetMap()
+		if mapRules.MinPairs != nil && mapRules.MaxPairs != nil && mapRules.GetMinPairs() > mapRules.GetMaxPairs() {
+			v.report(owner, append(path, "min_pairs"), "min_pairs must not be greater than max_pairs")
+		}
+		if mapRules.GetKeys() != nil {
+			v.checkFieldRules(ruleTarget{field: field.MapKey(), owner: owner, forItems: true, path: append(path, "keys")}, mapRules.GetKeys())
+		}
+		if mapRules.GetValues() != nil {
+			v.checkFieldRules(ruleTarget{field: field.MapValue(), owner: owner, forItems: true, path: append(path, "values")}, mapRules.GetValues())
+		}
+	}
+}
+
+// checkBounds checks that lower and upper bounds of numeric, duration and timestamp rules permit some value.
+func (v *protoValidateChecker) checkBounds(field protoreflect.FieldDescriptor, path []string, rules protoreflect.Message) {
+	oneofs := rules.Descriptor().Oneofs()
+	greaterThan, lessThan := oneofs.ByName("greater_than"), oneofs.ByName("less_than")
+	if greaterThan == nil || lessThan == nil {
+		return
+	}
+
+	lower, upper := rules.WhichOneof(greaterThan), rules.WhichOneof(lessThan)
+	if lower == nil || upper == nil {
+		return
+	}
+
+	if lower.Name() == "gt_now" && upper.Name() == "lt_now" {
+		v.report(field, append(path, string(lower.Name())), "gt_now and lt_now must not be set both")
+		return
+	}
+
+	if lower.Kind() != upper.Kind() || !rules.Get(lower).Equal(rules.Get(upper)) {
+		return
+	}
+
+	if lower.Name() == "gte" && upper.Name() == "lte" {
+		v.report(field, append(path, string(lower.Name())), "gte and lte are equal, use const instead")
+		return
+	}
+
+	v.report(field, append(path, string(lower.Name())),
+		"%s and %s are equal, all values are invalid", lower.Name(), upper.Name())
+}
+
+func (v *protoValidateChecker) checkStringRules(field protoreflect.FieldDescriptor, path []string, rules *validate.StringRules) {
+	if rules.Len != nil && (rules.MinLen != nil || rules.MaxLen != nil) {
+		v.report(field, append(path, "len"), "len must not be set with min_len or max_len")
+	}
+	if rules.MinLen != nil && rules.MaxLen != nil && rules.GetMinLen() >= rules.GetMaxLen() {
+		v.report(field, append(path, "min_len"), "min_len must be lower than max_len")
+	}
+	if rules.LenBytes != nil && (rules.MinBytes != nil || rules.MaxBytes != nil) {
+		v.report(field, append(path, "len_bytes"), "len_bytes must not be set with min_bytes or max_bytes")
+	}
+	if rules.MinBytes != nil && rules.MaxBytes != nil && rules.GetMinBytes() >= rules.GetMaxBytes() {
+		v.report(field, append(path, "min_bytes"), "min_bytes must be lower than max_bytes")
+	}
+	if rules.MinLen != nil && rules.MaxBytes != nil && rules.GetMinLen() > rules.GetMaxBytes() {
+		v.report(field, append(path, "min_len"), "min_len must not be greater than max_bytes")
+	}
+	if rules.MinBytes != nil && rules.MaxLen != nil && rules.GetMinBytes() > 4*rules.GetMaxLen() {
+		v.report(field, append(path, "min_bytes"), "min_bytes must not be greater than 4 times max_len")
+	}
+
+	for _, substr := range []struct {
+		name  string
+		value *string
+	}{
+		{name: "prefix", value: rules.Prefix},
+		{name: "suffix", value: rules.Suffix},
+		{name: "contains", value: rules.Contains},
+	} {
+		name, value := substr.name, substr.value
+		if value == nil {
+			continue
+		}
+
+		if rules.MaxLen != nil && uint64(utf8.RuneCountInString(*value)) > rules.GetMaxLen() {
+			v.report(field, append(path, name), "%s is longer than max_len, all values are invalid", name)
+		}
+		if rules.MaxBytes != nil && uint64(len(*value)) > rules.GetMaxBytes() {
+			v.report(field, append(path, name), "%s is longer than max_bytes, all values are invalid", name)
+		}
+		if rules.NotContains != nil && strings.Contains(*value, rules.GetNotContains()) {
+			v.report(field, append(path, name), "%s contains not_contains, all values are invalid", name)
+		}
+	}
+
+	if rules.Strict != nil && !rules.GetStrict() && rules.GetWellKnownRegex() == validate.KnownRegex_KNOWN_REGEX_UNSPECIFIED {
+		v.report(field, append(path, "strict"), "strict can only be set with well_known_regex")
+	}
+
+	if rules.Pattern != nil {
+		if _, err := regexp.Compile(rules.GetPattern()); err != nil {
+			v.report(field, append(path, "pattern"), "pattern is not a valid regular expression: %v", err)
+		}
+	}
+}
+
+func (v *protoValidateChecker) checkBytesRules(field protoreflect.FieldDescriptor, path []string, rules *validate.BytesRules) {
+	if rules.Len != nil && (rules.MinLen != nil || rules.MaxLen != nil) {
+		v.report(field, append(path, "len"), "len must not be set with min_len or max_len")
+	}
+	if rules.MinLen != nil && rules.MaxLen != nil && rules.GetMinLen() >= rules.GetMaxLen() {
+		v.report(field, append(path, "min_len"), "min_len must be lower than max_len")
+	}
+
+	if rules.MaxLen != nil {
+		for _, substr := range []struct {
+			name  string
+			value []byte
+		}{
+			{name: "prefix", value: rules.GetPrefix()},
+			{name: "suffix", value: rules.GetSuffix()},
+			{name: "contains", value: rules.GetContains()},
+		} {
+			if uint64(len(substr.value)) > rules.GetMaxLen() {
+				v.report(field, append(path, substr.name), "%s is longer than max_len, all values are invalid", substr.name)
+			}
+		}
+	}
+
+	if rules.Pattern != nil {
+		if _, err := regexp.Compile(rules.GetPattern()); err != nil {
+			v.report(field, append(path, "pattern"), "pattern is not a valid regular expression: %v", err)
+		}
+	}
+}
+
+func (v *protoValidateChecker) checkTimestampRules(field protoreflect.FieldDescriptor, path []string, rules *validate.TimestampRules) {
+	within := rules.GetWithin()
+	if within != nil && (within.GetSeconds() < 0 || within.GetSeconds() == 0 && within.GetNanos() <= 0) {
+		v.report(field, append(path, "within"), "within must be a positive duration")
+	}
+}
+
+// celIDPattern describes allowed characters of the CEL rule id.
+var celIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func (v *protoValidateChecker) checkCEL(d protoreflect.Descriptor, path []string, env *cel.Env, rules []*validate.Rule) {
+	ids := make(map[string]bool, len(rules))
+
+	for _, rule := range rules {
+		switch id := rule.GetId(); {
+		case id == "":
+			v.report(d, path, "CEL rule id must not be empty")
+		case !celIDPattern.MatchString(id):
+			v.report(d, path, "CEL rule id %q must contain only alphanumeric characters, '_', '-' and '.'", id)
+		case ids[id]:
+			v.report(d, path, "CEL rule id %q is not unique", id)
+		}
+		ids[rule.GetId()] = true
+
+		ast, celIssues := env.Compile(rule.GetExpression())
+		if err := celIssues.Err(); err != nil {
+			v.report(d, path, "CEL rule %q expression failed to compile: %v", rule.GetId(), err)
+			continue
+		}
+
+		outType := ast.OutputType()
+		switch {
+		case outType.IsExactType(cel.DynType):
+		case outType.IsAssignableType(cel.BoolType):
+			if rule.GetMessage() == "" {
+				v.report(d, path, "CEL rule %q evaluates to bool and must have a message", rule.GetId())
+			}
+		case outType.IsAssignableType(cel.StringType):
+			if rule.GetMessage() != "" {
+				v.report(d, path, "CEL rule %q evaluates to string and must not have a message", rule.GetId())
+			}
+		default:
+			v.report(d, path, "CEL rule %q evaluates to %s, wanted either bool or string", rule.GetId(), outType)
+		}
+	}
+}
+
+// wrapperTypes maps scalar rule types to the corresponding wrapper types.
+var wrapperTypes = map[string]protoreflect.FullName{
+	"float":  "google.protobuf.FloatValue",
+	"double": "google.protobuf.DoubleValue",
+	"int32":  "google.protobuf.Int32Value",
+	"int64":  "google.protobuf.Int64Value",
+	"uint32": "google.protobuf.UInt32Value",
+	"uint64": "google.protobuf.UInt64Value",
+	"bool":   "google.protobuf.BoolValue",
+	"string": "google.protobuf.StringValue",
+	"bytes":  "google.protobuf.BytesValue",
+}
+
+// messageRuleTypes maps rule types for well known messages to their full names.
+var messageRuleTypes = map[string]protoreflect.FullName{
+	"any":       "google.protobuf.Any",
+	"duration":  "google.protobuf.Duration",
+	"timestamp": "google.protobuf.Timestamp",
+}
+
+func isRuleTypeCompatible(ruleType string, target ruleTarget) bool {
+	field := target.field
+
+	if !target.forItems {
+		switch {
+		case field.IsMap():
+			return ruleType == "map"
+		case field.IsList():
+			return ruleType == "repeated"
+		}
+	}
+
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		name := field.Message().FullName()
+		return wrapperTypes[ruleType] == name || messageRuleTypes[ruleType] == name
+	}
+
+	if _, ok := messageRuleTypes[ruleType]; ok {
+		return false
+	}
+
+	return ruleType == field.Kind().String()
+}
+
+func targetTypeName(target ruleTarget) string {
+	field := target.field
+
+	switch {
+	case !target.forItems && field.IsMap():
+		return "map"
+	case !target.forItems && field.IsList():
+		return "repeated"
+	case field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind:
+		return string(field.Message().FullName())
+	default:
+		return field.Kind().String()
+	}
+}
+
+func isScalarOrWrapper(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+		return true
+	}
+
+	name := field.Message().FullName()
+	for _, wrapper := range wrapperTypes {
+		if wrapper == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func countSetFields(message protoreflect.Message) int {
+	count := 0
+	message.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		count++
+		return true
+	})
+
+	return count
 }
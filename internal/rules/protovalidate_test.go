@@ -0,0 +1,188 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+func TestProtovalidate_Message(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	const expMessage = "protovalidate constraints must be valid"
+
+	rule := rules.Protovalidate{}
+	message := rule.Message()
+
+	assert.Equal(expMessage, message)
+}
+
+func TestProtovalidate_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fileName   string
+		wantIssues *core.Issue
+		wantErr    error
+	}{
+		"invalid_type": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   117,
+					Line:     8,
+					Column:   20,
+				},
+				SourceName: "protovalidate.User.name",
+				Message:    "protovalidate constraints must be valid: int32 rules cannot be applied to field of type string",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_bounds": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   170,
+					Line:     9,
+					Column:   18,
+				},
+				SourceName: "protovalidate.User.age",
+				Message:    "protovalidate constraints must be valid: gt and lt are equal, all values are invalid",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_len": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   249,
+					Line:     13,
+					Column:   21,
+				},
+				SourceName: "protovalidate.User.email",
+				Message:    "protovalidate constraints must be valid: len must not be set with min_len or max_len",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_pattern": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   333,
+					Line:     17,
+					Column:   21,
+				},
+				SourceName: "protovalidate.User.phone",
+				Message:    "protovalidate constraints must be valid: pattern is not a valid regular expression: error parsing regexp: missing closing ]: `[0-9`",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_unique": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   409,
+					Line:     18,
+					Column:   30,
+				},
+				SourceName: "protovalidate.User.friends",
+				Message:    "protovalidate constraints must be valid: unique can only be set on repeated scalar or wrapper type fields",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_map_keys": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   489,
+					Line:     19,
+					Column:   35,
+				},
+				SourceName: "protovalidate.User.labels",
+				Message:    "protovalidate constraints must be valid: int32 rules cannot be applied to field of type string",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_cel_id": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   557,
+					Line:     20,
+					Column:   24,
+				},
+				SourceName: "protovalidate.User.nickname",
+				Message:    "protovalidate constraints must be valid: CEL rule id \"nickname format\" must contain only alphanumeric characters, '_', '-' and '.'",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_cel_message": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   557,
+					Line:     20,
+					Column:   24,
+				},
+				SourceName: "protovalidate.User.nickname",
+				Message:    "protovalidate constraints must be valid: CEL rule \"nickname format\" evaluates to bool and must have a message",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"invalid_required_oneof": {
+			fileName: protoValidateInvalid,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   695,
+					Line:     25,
+					Column:   26,
+				},
+				SourceName: "protovalidate.User.telegram",
+				Message:    "protovalidate constraints must be valid: required must not be set on a oneof member",
+				RuleName:   "PROTOVALIDATE",
+			},
+		},
+		"valid": {
+			fileName: protoValidateValid,
+			wantErr:  nil,
+		},
+		"not_compiled": {
+			fileName: invalidAuthProto,
+			wantErr:  nil,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, protos := start(t)
+
+			rule := rules.Protovalidate{}
+			issues, err := rule.Validate(protos[tc.fileName])
+			r.ErrorIs(err, tc.wantErr)
+			switch {
+			case tc.wantIssues != nil:
+				r.Contains(issues, *tc.wantIssues)
+			case len(issues) > 0:
+				r.Empty(issues)
+			}
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*RPCNoClientStreaming)(nil)
+var _ core.DocumentedRule = (*RPCNoClientStreaming)(nil)
 
 // RPCNoClientStreaming this rule checks that RPCs aren't client streaming.
 type RPCNoClientStreaming struct {
@@ -15,6 +15,25 @@ func (r *RPCNoClientStreaming) Message() string {
 	return "client streaming RPCs are not allowed"
 }
 
+// Doc implements core.DocumentedRule.
+func (r *RPCNoClientStreaming) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that rpc has no client streaming.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc Bar (stream BarRequest) returns (BarResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service Foo {
+    rpc Bar (BarRequest) returns (BarResponse) {}
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (r *RPCNoClientStreaming) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -22,7 +41,7 @@ func (r *RPCNoClientStreaming) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
 			if rpc.RPCRequest.IsStream {
-				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCName, rpc.Comments)
+				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCName)
 			}
 		}
 	}
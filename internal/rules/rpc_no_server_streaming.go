@@ -4,7 +4,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*RPCNoServerStreaming)(nil)
+var _ core.DocumentedRule = (*RPCNoServerStreaming)(nil)
 
 // RPCNoServerStreaming this rule checks that RPCs aren't server streaming.
 type RPCNoServerStreaming struct {
@@ -15,6 +15,25 @@ func (r *RPCNoServerStreaming) Message() string {
 	return "server streaming RPCs are not allowed"
 }
 
+// Doc implements core.DocumentedRule.
+func (r *RPCNoServerStreaming) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that rpc has no server streaming.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc Bar (BarRequest) returns (stream BarResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service Foo {
+    rpc Bar (BarRequest) returns (BarResponse) {}
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (r *RPCNoServerStreaming) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -22,7 +41,7 @@ func (r *RPCNoServerStreaming) Validate(protoInfo core.ProtoInfo) ([]core.Issue,
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
 			if rpc.RPCResponse.IsStream {
-				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCName, rpc.Comments)
+				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCName)
 			}
 		}
 	}
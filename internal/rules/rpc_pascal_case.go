@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*RPCPascalCase)(nil)
+var _ core.DocumentedRule = (*RPCPascalCase)(nil)
 
 // RPCPascalCase this rule checks that RPCs are PascalCase.
 type RPCPascalCase struct{}
@@ -16,6 +16,25 @@ func (c *RPCPascalCase) Message() string {
 	return "RPC names should be PascalCase"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *RPCPascalCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all RPC names are in PascalCase.",
+		Bad: `syntax = "proto3";
+
+service foo_bar {
+    rpc get_foo_bar (FooBarRequest) returns (FooBarResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service FooBar {
+    rpc GetFooBar (FooBarRequest) returns (FooBarResponse) {}
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *RPCPascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -23,7 +42,7 @@ func (c *RPCPascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error)
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
 			if !pascalCase.MatchString(rpc.RPCName) {
-				res = core.AppendIssue(res, c, rpc.Meta.Pos, rpc.RPCName, rpc.Comments)
+				res = core.AppendIssue(res, c, rpc.Meta.Pos, rpc.RPCName)
 			}
 		}
 	}
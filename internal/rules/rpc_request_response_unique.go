@@ -1,15 +1,26 @@
 package rules
 
 import (
+	"strings"
+
 	"github.com/samber/lo"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*RPCRequestResponseUnique)(nil)
+var _ core.DocumentedRule = (*RPCRequestResponseUnique)(nil)
 
 // RPCRequestResponseUnique checks that RPCs request and response types are only used in one RPC.
+// Types are compared by full names if compiled descriptor is available,
+// otherwise they are compared as they are written in the proto file.
 type RPCRequestResponseUnique struct {
+	// AllowSameRequestResponse allows the same type as request and response of one RPC.
+	AllowSameRequestResponse bool
+	// AllowGoogleProtobufEmptyRequests allows google.protobuf.Empty as request type of any RPC.
+	AllowGoogleProtobufEmptyRequests bool
+	// AllowGoogleProtobufEmptyResponses allows google.protobuf.Empty as response type of any RPC.
+	AllowGoogleProtobufEmptyResponses bool
 }
 
 // Message implements lint.Rule.
@@ -17,25 +28,109 @@ func (r *RPCRequestResponseUnique) Message() string {
 	return "request and response types must be unique across all RPCs"
 }
 
+// Doc implements core.DocumentedRule.
+func (r *RPCRequestResponseUnique) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all RPC request and response messages are unique.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc GetFoo (FooRequest) returns (FooResponse) {}
+    rpc GetBar (FooRequest) returns (FooResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service Foo {
+    rpc GetFoo (FooRequest) returns (FooResponse) {}
+    rpc GetBar (BarRequest) returns (BarResponse) {}
+}
+`,
+		Options: []string{
+			"lint.rpc_allow_same_request_response",
+			"lint.rpc_allow_google_protobuf_empty_requests",
+			"lint.rpc_allow_google_protobuf_empty_responses",
+		},
+	}
+}
+
 // Validate implements lint.Rule.
 func (r *RPCRequestResponseUnique) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	if protoInfo.Descriptor != nil {
+		return r.validateDescriptor(protoInfo.Descriptor), nil
+	}
+
 	var res []core.Issue
 	var messages []string
 
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
-			if !lo.Contains(messages, rpc.RPCRequest.MessageType) {
-				messages = append(messages, rpc.RPCRequest.MessageType)
-			} else {
-				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCRequest.MessageType, rpc.Comments)
+			request, response := rpc.RPCRequest.MessageType, rpc.RPCResponse.MessageType
+
+			if !r.AllowGoogleProtobufEmptyRequests || !isGoogleProtobufEmpty(request) {
+				if !lo.Contains(messages, request) {
+					messages = append(messages, request)
+				} else {
+					res = core.AppendIssue(res, r, rpc.Meta.Pos, request)
+				}
 			}
-			if !lo.Contains(messages, rpc.RPCResponse.MessageType) {
-				messages = append(messages, rpc.RPCResponse.MessageType)
+
+			if r.AllowGoogleProtobufEmptyResponses && isGoogleProtobufEmpty(response) {
+				continue
+			}
+			if r.AllowSameRequestResponse && request == response {
+				continue
+			}
+
+			if !lo.Contains(messages, response) {
+				messages = append(messages, response)
 			} else {
-				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCResponse.MessageType, rpc.Comments)
+				res = core.AppendIssue(res, r, rpc.Meta.Pos, response)
 			}
 		}
 	}
 
 	return res, nil
 }
+
+func (r *RPCRequestResponseUnique) validateDescriptor(fd protoreflect.FileDescriptor) []core.Issue {
+	var res []core.Issue
+	messages := make(map[protoreflect.FullName]struct{})
+
+	services := fd.Services()
+	for i := range services.Len() {
+		methods := services.Get(i).Methods()
+		for j := range methods.Len() {
+			method := methods.Get(j)
+
+			var check []protoreflect.MessageDescriptor
+			if !r.AllowGoogleProtobufEmptyRequests || !isGoogleProtobufEmpty(string(method.Input().FullName())) {
+				check = append(check, method.Input())
+			}
+
+			switch {
+			case r.AllowGoogleProtobufEmptyResponses && isGoogleProtobufEmpty(string(method.Output().FullName())):
+			case r.AllowSameRequestResponse && method.Input().FullName() == method.Output().FullName():
+			default:
+				check = append(check, method.Output())
+			}
+
+			for _, message := range check {
+				if _, ok := messages[message.FullName()]; !ok {
+					messages[message.FullName()] = struct{}{}
+					continue
+				}
+
+				res = core.AppendDescriptorIssue(res, r, method, string(message.FullName()))
+			}
+		}
+	}
+
+	return res
+}
+
+// isGoogleProtobufEmpty reports whether message type is google.protobuf.Empty,
+// the type may be written fully qualified with the leading dot.
+func isGoogleProtobufEmpty(messageType string) bool {
+	return strings.TrimPrefix(messageType, ".") == "google.protobuf.Empty"
+}
@@ -27,6 +27,7 @@ func TestRPCRequestResponseUnique_Validate(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
+		rule       rules.RPCRequestResponseUnique
 		fileName   string
 		wantIssues *core.Issue
 		wantErr    error
@@ -49,6 +50,61 @@ func TestRPCRequestResponseUnique_Validate(t *testing.T) {
 			fileName: validAuthProto,
 			wantErr:  nil,
 		},
+		"invalid_compiled": {
+			fileName: rpcNotUniqueCompiled,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   218,
+					Line:     12,
+					Column:   3,
+				},
+				SourceName: "rpc_request_response_unique.GetRequest",
+				Message:    "request and response types must be unique across all RPCs",
+				RuleName:   "RPC_REQUEST_RESPONSE_UNIQUE",
+			},
+		},
+		"invalid_empty": {
+			fileName: rpcEmpty,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   190,
+					Line:     9,
+					Column:   3,
+				},
+				SourceName: "google.protobuf.Empty",
+				Message:    "request and response types must be unique across all RPCs",
+				RuleName:   "RPC_REQUEST_RESPONSE_UNIQUE",
+			},
+		},
+		"valid_allow_empty": {
+			rule: rules.RPCRequestResponseUnique{
+				AllowGoogleProtobufEmptyRequests:  true,
+				AllowGoogleProtobufEmptyResponses: true,
+			},
+			fileName: rpcEmpty,
+			wantErr:  nil,
+		},
+		"invalid_same": {
+			fileName: rpcSame,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   82,
+					Line:     6,
+					Column:   3,
+				},
+				SourceName: "rpc_request_response_unique.EchoRequest",
+				Message:    "request and response types must be unique across all RPCs",
+				RuleName:   "RPC_REQUEST_RESPONSE_UNIQUE",
+			},
+		},
+		"valid_allow_same": {
+			rule:     rules.RPCRequestResponseUnique{AllowSameRequestResponse: true},
+			fileName: rpcSame,
+			wantErr:  nil,
+		},
 	}
 
 	for name, tc := range tests {
@@ -58,7 +114,7 @@ func TestRPCRequestResponseUnique_Validate(t *testing.T) {
 
 			r, protos := start(t)
 
-			rule := rules.RPCRequestResponseUnique{}
+			rule := tc.rule
 			issues, err := rule.Validate(protos[tc.fileName])
 			r.ErrorIs(err, tc.wantErr)
 			switch {
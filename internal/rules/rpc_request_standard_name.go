@@ -4,10 +4,12 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*RPCRequestStandardName)(nil)
+var _ core.DocumentedRule = (*RPCRequestStandardName)(nil)
 
 // RPCRequestStandardName checks that RPC request type names are RPCNameRequest or ServiceNameRPCNameRequest.
 type RPCRequestStandardName struct {
+	// AllowGoogleProtobufEmptyRequests allows google.protobuf.Empty as request type.
+	AllowGoogleProtobufEmptyRequests bool
 }
 
 // Message implements lint.Rule.
@@ -15,14 +17,38 @@ func (r *RPCRequestStandardName) Message() string {
 	return "rpc request should have suffix 'Request'"
 }
 
+// Doc implements core.DocumentedRule.
+func (r *RPCRequestStandardName) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all RPC request messages are named `MethodRequest`.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc GetFoo (FooRequest) returns (FooResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service Foo {
+    rpc GetFoo (GetFooRequest) returns (FooResponse) {}
+}
+`,
+		Options: []string{"lint.rpc_allow_google_protobuf_empty_requests"},
+	}
+}
+
 // Validate implements lint.Rule.
 func (r *RPCRequestStandardName) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
+			if r.AllowGoogleProtobufEmptyRequests && isGoogleProtobufEmpty(rpc.RPCRequest.MessageType) {
+				continue
+			}
+
 			if rpc.RPCRequest.MessageType != rpc.RPCName+"Request" && rpc.RPCRequest.MessageType != service.ServiceName+rpc.RPCName+"Request" {
-				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCRequest.MessageType, rpc.Comments)
+				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCRequest.MessageType)
 			}
 		}
 	}
@@ -27,6 +27,7 @@ func TestRPCRequestStandardName_Validate(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
+		rule       rules.RPCRequestStandardName
 		fileName   string
 		wantIssues *core.Issue
 		wantErr    error
@@ -49,6 +50,25 @@ func TestRPCRequestStandardName_Validate(t *testing.T) {
 			fileName: validAuthProto,
 			wantErr:  nil,
 		},
+		"invalid_empty": {
+			fileName: rpcEmpty,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   123,
+					Line:     8,
+					Column:   3,
+				},
+				SourceName: "google.protobuf.Empty",
+				Message:    "rpc request should have suffix 'Request'",
+				RuleName:   "RPC_REQUEST_STANDARD_NAME",
+			},
+		},
+		"valid_allow_empty": {
+			rule:     rules.RPCRequestStandardName{AllowGoogleProtobufEmptyRequests: true},
+			fileName: rpcEmpty,
+			wantErr:  nil,
+		},
 	}
 
 	for name, tc := range tests {
@@ -58,7 +78,7 @@ func TestRPCRequestStandardName_Validate(t *testing.T) {
 
 			r, protos := start(t)
 
-			rule := rules.RPCRequestStandardName{}
+			rule := tc.rule
 			issues, err := rule.Validate(protos[tc.fileName])
 			r.ErrorIs(err, tc.wantErr)
 			switch {
@@ -4,10 +4,12 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*RPCResponseStandardName)(nil)
+var _ core.DocumentedRule = (*RPCResponseStandardName)(nil)
 
 // RPCResponseStandardName checks that RPC response type names are RPCNameResponse or ServiceNameRPCNameResponse.
 type RPCResponseStandardName struct {
+	// AllowGoogleProtobufEmptyResponses allows google.protobuf.Empty as response type.
+	AllowGoogleProtobufEmptyResponses bool
 }
 
 // Message implements lint.Rule.
@@ -15,14 +17,38 @@ func (r *RPCResponseStandardName) Message() string {
 	return "rpc response should have suffix 'Response'"
 }
 
+// Doc implements core.DocumentedRule.
+func (r *RPCResponseStandardName) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all RPC response messages are named `MethodResponse`.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc GetFoo (GetFooRequest) returns (Foo) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service Foo {
+    rpc GetFoo (GetFooRequest) returns (GetFooResponse) {}
+}
+`,
+		Options: []string{"lint.rpc_allow_google_protobuf_empty_responses"},
+	}
+}
+
 // Validate implements lint.Rule.
 func (r *RPCResponseStandardName) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		for _, rpc := range service.ServiceBody.RPCs {
+			if r.AllowGoogleProtobufEmptyResponses && isGoogleProtobufEmpty(rpc.RPCResponse.MessageType) {
+				continue
+			}
+
 			if rpc.RPCResponse.MessageType != rpc.RPCName+"Response" && rpc.RPCResponse.MessageType != service.ServiceName+rpc.RPCName+"Response" {
-				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCResponse.MessageType, rpc.Comments)
+				res = core.AppendIssue(res, r, rpc.Meta.Pos, rpc.RPCResponse.MessageType)
 			}
 		}
 	}
@@ -27,6 +27,7 @@ func TestRPCResponseStandardName_Validate(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
+		rule       rules.RPCResponseStandardName
 		fileName   string
 		wantIssues *core.Issue
 		wantErr    error
@@ -49,6 +50,25 @@ func TestRPCResponseStandardName_Validate(t *testing.T) {
 			fileName: validAuthProto,
 			wantErr:  nil,
 		},
+		"invalid_empty": {
+			fileName: rpcEmpty,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   123,
+					Line:     8,
+					Column:   3,
+				},
+				SourceName: "google.protobuf.Empty",
+				Message:    "rpc response should have suffix 'Response'",
+				RuleName:   "RPC_RESPONSE_STANDARD_NAME",
+			},
+		},
+		"valid_allow_empty": {
+			rule:     rules.RPCResponseStandardName{AllowGoogleProtobufEmptyResponses: true},
+			fileName: rpcEmpty,
+			wantErr:  nil,
+		},
 	}
 
 	for name, tc := range tests {
@@ -58,7 +78,7 @@ func TestRPCResponseStandardName_Validate(t *testing.T) {
 
 			r, protos := start(t)
 
-			rule := rules.RPCResponseStandardName{}
+			rule := tc.rule
 			issues, err := rule.Validate(protos[tc.fileName])
 			r.ErrorIs(err, tc.wantErr)
 			switch {
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*ServicePascalCase)(nil)
+var _ core.DocumentedRule = (*ServicePascalCase)(nil)
 
 // ServicePascalCase this rule checks that services are PascalCase.
 type ServicePascalCase struct{}
@@ -16,6 +16,25 @@ func (c *ServicePascalCase) Message() string {
 	return "service names must be PascalCase"
 }
 
+// Doc implements core.DocumentedRule.
+func (c *ServicePascalCase) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all service names are in PascalCase.",
+		Bad: `syntax = "proto3";
+
+service foo_bar {
+    rpc get_foo_bar (FooBarRequest) returns (FooBarResponse) {}
+}
+`,
+		Good: `syntax = "proto3";
+
+service FooBar {
+    rpc GetFooBar (FooBarRequest) returns (FooBarResponse) {}
+}
+`,
+	}
+}
+
 // Validate implements lint.Rule.
 func (c *ServicePascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
@@ -23,7 +42,7 @@ func (c *ServicePascalCase) Validate(protoInfo core.ProtoInfo) ([]core.Issue, er
 	pascalCase := regexp.MustCompile("^[A-Z][a-z]+([A-Z]|[a-z]+)*$")
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		if !pascalCase.MatchString(service.ServiceName) {
-			res = core.AppendIssue(res, c, service.Meta.Pos, service.ServiceName, service.Comments)
+			res = core.AppendIssue(res, c, service.Meta.Pos, service.ServiceName)
 		}
 	}
 
@@ -6,7 +6,7 @@ import (
 	"github.com/easyp-tech/easyp/internal/core"
 )
 
-var _ core.Rule = (*ServiceSuffix)(nil)
+var _ core.DocumentedRule = (*ServiceSuffix)(nil)
 
 // ServiceSuffix this rule enforces that all services are suffixed with Service.
 type ServiceSuffix struct {
@@ -18,13 +18,33 @@ func (s *ServiceSuffix) Message() string {
 	return "service name should have suffix"
 }
 
+// Doc implements core.DocumentedRule.
+func (s *ServiceSuffix) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that all services are suffixed with `Service` or your custom suffix.",
+		Bad: `syntax = "proto3";
+
+service Foo {
+    rpc Bar(BarRequest) returns (BarResponse);
+}
+`,
+		Good: `syntax = "proto3";
+
+service FooService {
+    rpc Bar(BarRequest) returns (BarResponse);
+}
+`,
+		Options: []string{"lint.service_suffix"},
+	}
+}
+
 // Validate enforces that all services are suffixed with Service.
 func (s *ServiceSuffix) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
 	var res []core.Issue
 
 	for _, service := range protoInfo.Info.ProtoBody.Services {
 		if !strings.HasSuffix(service.ServiceName, s.Suffix) {
-			res = core.AppendIssue(res, s, service.Meta.Pos, service.ServiceName, service.Comments)
+			res = core.AppendIssue(res, s, service.Meta.Pos, service.ServiceName)
 		}
 	}
 
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+var _ core.DocumentedRule = (*StablePackageNoImportUnstable)(nil)
+
+// StablePackageNoImportUnstable this rule outlaws imports of files from unstable packages,
+// such as foo.bar.v1alpha1, foo.bar.v1beta1 or foo.bar.v1test, into stable packages, such as foo.bar.v1.
+// Stable API must not depend on API which can change at any time.
+type StablePackageNoImportUnstable struct{}
+
+// Message implements lint.Rule.
+func (s *StablePackageNoImportUnstable) Message() string {
+	return "stable package should not import unstable packages"
+}
+
+// Doc implements core.DocumentedRule.
+func (s *StablePackageNoImportUnstable) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that files from stable packages, such as `foo.bar.v1`, don't import files from unstable packages, such as `foo.bar.v1alpha1`, `foo.bar.v1beta1` or `foo.bar.v1test`. Stable API must not depend on API which can change at any time.",
+		Bad: `syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1beta1/bar.proto";
+`,
+		Good: `syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/bar.proto";
+`,
+	}
+}
+
+var (
+	matchStablePackage   = regexp.MustCompile(`(^|\.)v\d+$`)
+	matchUnstablePackage = regexp.MustCompile(`(^|\.)v\d+(test.*|(alpha|beta)\d*|p\d+(alpha|beta)\d*)$`)
+)
+
+// Validate implements lint.Rule.
+func (s *StablePackageNoImportUnstable) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	var res []core.Issue
+
+	if len(protoInfo.Info.ProtoBody.Packages) == 0 ||
+		!matchStablePackage.MatchString(protoInfo.Info.ProtoBody.Packages[0].Name) {
+		return res, nil
+	}
+
+	for _, imp := range protoInfo.Info.ProtoBody.Imports {
+		imported, ok := protoInfo.ProtoFilesFromImport[core.ConvertImportPath(imp.Location)]
+		if !ok || len(imported.ProtoBody.Packages) == 0 {
+			continue
+		}
+
+		if matchUnstablePackage.MatchString(imported.ProtoBody.Packages[0].Name) {
+			res = core.AppendIssue(res, s, imp.Meta.Pos, imp.Location)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,72 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+func TestStablePackageNoImportUnstable_Message(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	const expMessage = "stable package should not import unstable packages"
+
+	rule := rules.StablePackageNoImportUnstable{}
+	message := rule.Message()
+
+	assert.Equal(expMessage, message)
+}
+
+func TestStablePackageNoImportUnstable_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fileName   string
+		wantIssues *core.Issue
+		wantErr    error
+	}{
+		"invalid": {
+			fileName: stablePackage,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   94,
+					Line:     6,
+					Column:   1,
+				},
+				SourceName: `"stable_package/foo/v1beta1/bar.proto"`,
+				Message:    "stable package should not import unstable packages",
+				RuleName:   "STABLE_PACKAGE_NO_IMPORT_UNSTABLE",
+			},
+		},
+		"valid": {
+			fileName: validAuthProto,
+			wantErr:  nil,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, protos := start(t)
+
+			rule := rules.StablePackageNoImportUnstable{}
+			issues, err := rule.Validate(protos[tc.fileName])
+			r.ErrorIs(err, tc.wantErr)
+			switch {
+			case tc.wantIssues != nil:
+				r.Contains(issues, *tc.wantIssues)
+			case len(issues) > 0:
+				r.Empty(issues)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+)
+
+var _ core.DocumentedRule = (*SyntaxSpecified)(nil)
+
+// SyntaxSpecified this rule enforces that syntax or edition is specified in every file.
+// Files without the declaration are treated as proto2 by the compiler, which is rarely intended.
+type SyntaxSpecified struct{}
+
+// Message implements lint.Rule.
+func (s *SyntaxSpecified) Message() string {
+	return "syntax or edition should be specified"
+}
+
+// Doc implements core.DocumentedRule.
+func (s *SyntaxSpecified) Doc() core.RuleDoc {
+	return core.RuleDoc{
+		Description: "This rule checks that every file declares `syntax` or `edition`. Files without the declaration are silently treated as `proto2`.",
+		Bad: `package foo;
+
+message Foo {
+    optional string bar = 1;
+}
+`,
+		Good: `syntax = "proto3";
+
+package foo;
+
+message Foo {
+    optional string bar = 1;
+}
+`,
+	}
+}
+
+// Validate implements lint.Rule.
+func (s *SyntaxSpecified) Validate(protoInfo core.ProtoInfo) ([]core.Issue, error) {
+	var res []core.Issue
+
+	if !isSyntaxSpecified(protoInfo) {
+		res = core.AppendIssue(res, s, meta.Position{
+			Filename: "",
+			Offset:   0,
+			Line:     0,
+			Column:   0,
+		}, protoInfo.Path)
+	}
+
+	return res, nil
+}
+
+// isSyntaxSpecified go-protoparser doesn't keep edition declaration,
+// so compiled AST is preferred when it is available.
+func isSyntaxSpecified(protoInfo core.ProtoInfo) bool {
+	if res, ok := protoInfo.Descriptor.(linker.Result); ok && res.AST() != nil {
+		return res.AST().Syntax != nil || res.AST().Edition != nil
+	}
+
+	return protoInfo.Info.Syntax != nil
+}
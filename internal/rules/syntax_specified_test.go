@@ -0,0 +1,76 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/easyp-tech/easyp/internal/core"
+	"github.com/easyp-tech/easyp/internal/rules"
+)
+
+func TestSyntaxSpecified_Message(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	const expMessage = "syntax or edition should be specified"
+
+	rule := rules.SyntaxSpecified{}
+	message := rule.Message()
+
+	assert.Equal(expMessage, message)
+}
+
+func TestSyntaxSpecified_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fileName   string
+		wantIssues *core.Issue
+		wantErr    error
+	}{
+		"invalid": {
+			fileName: syntaxNotSpecified,
+			wantIssues: &core.Issue{
+				Position: meta.Position{
+					Filename: "",
+					Offset:   0,
+					Line:     0,
+					Column:   0,
+				},
+				SourceName: syntaxNotSpecified,
+				Message:    "syntax or edition should be specified",
+				RuleName:   "SYNTAX_SPECIFIED",
+			},
+		},
+		"valid_edition": {
+			fileName: syntaxEdition,
+			wantErr:  nil,
+		},
+		"valid": {
+			fileName: validAuthProto,
+			wantErr:  nil,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, protos := start(t)
+
+			rule := rules.SyntaxSpecified{}
+			issues, err := rule.Validate(protos[tc.fileName])
+			r.ErrorIs(err, tc.wantErr)
+			switch {
+			case tc.wantIssues != nil:
+				r.Contains(issues, *tc.wantIssues)
+			case len(issues) > 0:
+				r.Empty(issues)
+			}
+		})
+	}
+}
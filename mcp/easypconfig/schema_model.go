@@ -18,6 +18,45 @@ type configSchemaLint struct {
 	Except              []string            `json:"except,omitempty"`
 	AllowCommentIgnores bool                `json:"allow_comment_ignores,omitempty"`
 	IgnoreOnly          map[string][]string `json:"ignore_only,omitempty"`
+
+	CommentIgnoresRequireJustification bool `json:"comment_ignores_require_justification,omitempty"`
+
+	RPCAllowSameRequestResponse          bool `json:"rpc_allow_same_request_response,omitempty"`
+	RPCAllowGoogleProtobufEmptyRequests  bool `json:"rpc_allow_google_protobuf_empty_requests,omitempty"`
+	RPCAllowGoogleProtobufEmptyResponses bool `json:"rpc_allow_google_protobuf_empty_responses,omitempty"`
+
+	Plugins   []configSchemaLintPlugin        `json:"plugins,omitempty"`
+	Severity  map[string]configSchemaSeverity `json:"severity,omitempty"`
+	Overrides []configSchemaLintOverride      `json:"overrides,omitempty"`
+}
+
+type configSchemaLintOverride struct {
+	Path                string   `json:"path"`
+	Use                 []string `json:"use,omitempty"`
+	Except              []string `json:"except,omitempty"`
+	EnumZeroValueSuffix string   `json:"enum_zero_value_suffix,omitempty"`
+	ServiceSuffix       string   `json:"service_suffix,omitempty"`
+}
+
+type configSchemaSeverity string
+
+func (configSchemaSeverity) JSONSchemaExtend(schema *invjsonschema.Schema) {
+	schema.Enum = []any{"error", "warning", "info"}
+}
+
+type configSchemaLintPlugin struct {
+	Path    string                 `json:"path,omitempty"`
+	Command []string               `json:"command,omitempty"`
+	Wasm    string                 `json:"wasm,omitempty"`
+	Opts    configSchemaPluginOpts `json:"opts,omitempty"`
+}
+
+func (configSchemaLintPlugin) JSONSchemaExtend(schema *invjsonschema.Schema) {
+	schema.OneOf = []*invjsonschema.Schema{
+		{Required: []string{"path"}},
+		{Required: []string{"command"}},
+		{Required: []string{"wasm"}},
+	}
 }
 
 type configSchemaGenerate struct {
@@ -171,8 +210,14 @@ func (configSchemaManagedOverrideRule) JSONSchemaExtend(schema *invjsonschema.Sc
 }
 
 type configSchemaBreaking struct {
-	Ignore        []string `json:"ignore,omitempty"`
-	AgainstGitRef string   `json:"against_git_ref,omitempty"`
+	Use                    []string            `json:"use,omitempty"`
+	Except                 []string            `json:"except,omitempty"`
+	Ignore                 []string            `json:"ignore,omitempty"`
+	IgnoreOnly             map[string][]string `json:"ignore_only,omitempty"`
+	IgnoreUnstablePackages bool                `json:"ignore_unstable_packages,omitempty"`
+	AgainstGitRef          string              `json:"against_git_ref,omitempty"`
+	AgainstModule          string              `json:"against_module,omitempty"`
+	AgainstInput           string              `json:"against_input,omitempty"`
 }
 
 func setMinItems(schema *invjsonschema.Schema, fieldName string, min uint64) {
@@ -30,10 +30,17 @@ func docsByPath() map[string]nodeDoc {
 				{Path: "lint.use", Type: "array<string>", Required: false, Description: "Rule groups and/or individual lint rule names.", AllowedValues: lintUseAllowedValues(), DefaultValue: "[]"},
 				{Path: "lint.enum_zero_value_suffix", Type: "string", Required: false, Description: "Required suffix for enum zero value.", DefaultValue: "UNSPECIFIED (runtime default)"},
 				{Path: "lint.service_suffix", Type: "string", Required: false, Description: "Required suffix for service names.", DefaultValue: "Service (runtime default)"},
-				{Path: "lint.ignore", Type: "array<string>", Required: false, Description: "Paths to exclude from linting.", DefaultValue: "[]"},
+				{Path: "lint.ignore", Type: "array<string>", Required: false, Description: "Paths or doublestar globs to exclude from linting.", DefaultValue: "[]", Examples: []string{`["vendor","**/*_internal.proto"]`}},
 				{Path: "lint.except", Type: "array<string>", Required: false, Description: "Rules to disable globally.", DefaultValue: "[]"},
 				{Path: "lint.allow_comment_ignores", Type: "boolean", Required: false, Description: "Allow inline ignore comments in proto files.", DefaultValue: "false"},
-				{Path: "lint.ignore_only", Type: "map<string, array<string>>", Required: false, Description: "Disable specific rules only for selected paths.", DefaultValue: "{}"},
+				{Path: "lint.comment_ignores_require_justification", Type: "boolean", Required: false, Description: "Apply only ignore comments with a justification after \"--\", e.g. \"// nolint:COMMENT_FIELD -- generated\".", DefaultValue: "false"},
+				{Path: "lint.ignore_only", Type: "map<string, array<string>>", Required: false, Description: "Disable specific rules only for selected paths or doublestar globs.", DefaultValue: "{}"},
+				{Path: "lint.rpc_allow_same_request_response", Type: "boolean", Required: false, Description: "Allow the same message as request and response of one RPC in RPC_REQUEST_RESPONSE_UNIQUE.", DefaultValue: "false"},
+				{Path: "lint.rpc_allow_google_protobuf_empty_requests", Type: "boolean", Required: false, Description: "Allow google.protobuf.Empty as RPC request in RPC_REQUEST_RESPONSE_UNIQUE and RPC_REQUEST_STANDARD_NAME.", DefaultValue: "false"},
+				{Path: "lint.rpc_allow_google_protobuf_empty_responses", Type: "boolean", Required: false, Description: "Allow google.protobuf.Empty as RPC response in RPC_REQUEST_RESPONSE_UNIQUE and RPC_RESPONSE_STANDARD_NAME.", DefaultValue: "false"},
+				{Path: "lint.plugins", Type: "array<object>", Required: false, Description: "External lint plugins executed as local binaries, commands or WASM modules.", DefaultValue: "[]"},
+				{Path: "lint.severity", Type: "map<string, string>", Required: false, Description: "Severity of rules or groups: error, warning or info. Severity of a rule overrides severity of its group.", DefaultValue: "{}", Examples: []string{`{"COMMENTS":"warning","COMMENT_SERVICE":"error"}`}},
+				{Path: "lint.overrides", Type: "array<object>", Required: false, Description: "Lint configuration of files matching a path. The last matching override is used for a file.", DefaultValue: "[]"},
 			},
 			Examples: []Example{
 				{
@@ -48,6 +55,45 @@ func docsByPath() map[string]nodeDoc {
 					YAML:        "lint:\n  use:\n    - DEFAULT\n  ignore_only:\n    PACKAGE_VERSION_SUFFIX:\n      - proto/legacy\n    RPC_REQUEST_STANDARD_NAME:\n      - proto/public\n",
 					Paths:       []string{"lint"},
 				},
+				{
+					Title:       "lint_rpc_exceptions",
+					Description: "Allow google.protobuf.Empty and the same request/response message in RPC rules.",
+					YAML:        "lint:\n  use:\n    - DEFAULT\n  rpc_allow_same_request_response: true\n  rpc_allow_google_protobuf_empty_requests: true\n  rpc_allow_google_protobuf_empty_responses: true\n",
+					Paths:       []string{"lint"},
+				},
+			},
+		},
+		"lint.plugins[]": {
+			Fields: []FieldDoc{
+				{Path: "lint.plugins[].path", Type: "string", Required: false, Description: "Path to lint plugin binary (one source option).", Examples: []string{"./bin/easyp-lint-acme"}},
+				{Path: "lint.plugins[].command", Type: "array<string>", Required: false, Description: "Command invocation for lint plugin (one source option).", Examples: []string{`["go","run","./cmd/easyp-lint-acme"]`}},
+				{Path: "lint.plugins[].wasm", Type: "string", Required: false, Description: "Path to lint plugin WASM module executed via wazero (one source option).", Examples: []string{"./plugins/acme-lint.wasm"}},
+				{Path: "lint.plugins[].opts", Type: "map<string, string | number | boolean | array<string | number | boolean>>", Required: false, Description: "Rule configuration passed to plugin as CodeGeneratorRequest parameter."},
+			},
+			Examples: []Example{
+				{
+					Title:       "lint_plugins",
+					Description: "Lint plugins from local binary and WASM module.",
+					YAML:        "lint:\n  use:\n    - DEFAULT\n  plugins:\n    - path: ./bin/easyp-lint-acme\n      opts:\n        max_fields: 20\n    - wasm: ./plugins/acme-lint.wasm\n",
+					Paths:       []string{"lint.plugins[]"},
+				},
+			},
+		},
+		"lint.overrides[]": {
+			Fields: []FieldDoc{
+				{Path: "lint.overrides[].path", Type: "string", Required: true, Description: "Directory, file or doublestar glob of files the override is applied to.", Examples: []string{"proto/legacy", "**/*_internal.proto"}},
+				{Path: "lint.overrides[].use", Type: "array<string>", Required: false, Description: "Rules or groups enabled in addition to lint.use.", DefaultValue: "[]"},
+				{Path: "lint.overrides[].except", Type: "array<string>", Required: false, Description: "Rules or groups disabled for matching files.", DefaultValue: "[]"},
+				{Path: "lint.overrides[].enum_zero_value_suffix", Type: "string", Required: false, Description: "Enum zero value suffix for matching files, lint.enum_zero_value_suffix by default."},
+				{Path: "lint.overrides[].service_suffix", Type: "string", Required: false, Description: "Service suffix for matching files, lint.service_suffix by default."},
+			},
+			Examples: []Example{
+				{
+					Title:       "lint_overrides",
+					Description: "Legacy protos without comments and with API service suffix.",
+					YAML:        "lint:\n  use:\n    - DEFAULT\n    - COMMENTS\n  overrides:\n    - path: proto/legacy/**\n      except:\n        - COMMENTS\n      service_suffix: API\n",
+					Paths:       []string{"lint.overrides[]"},
+				},
 			},
 		},
 		"deps": {
@@ -269,8 +315,14 @@ func docsByPath() map[string]nodeDoc {
 		},
 		"breaking": {
 			Fields: []FieldDoc{
-				{Path: "breaking.ignore", Type: "array<string>", Required: false, Description: "Paths excluded from breaking-change checks.", DefaultValue: "[]"},
+				{Path: "breaking.use", Type: "array<string>", Required: false, Description: "Breaking rules or categories to check: FILE, PACKAGE, WIRE_JSON, WIRE.", DefaultValue: "[FILE]"},
+				{Path: "breaking.except", Type: "array<string>", Required: false, Description: "Breaking rules or categories excluded from `use`.", DefaultValue: "[]"},
+				{Path: "breaking.ignore", Type: "array<string>", Required: false, Description: "Paths or doublestar globs excluded from breaking-change checks.", DefaultValue: "[]"},
+				{Path: "breaking.ignore_only", Type: "map<string, array<string>>", Required: false, Description: "Paths or doublestar globs excluded from specific breaking rules or categories.", DefaultValue: "{}"},
+				{Path: "breaking.ignore_unstable_packages", Type: "bool", Required: false, Description: "Skip checks of alpha, beta and test packages, e.g. acme.v1beta1.", DefaultValue: "false"},
 				{Path: "breaking.against_git_ref", Type: "string", Required: false, Description: "Branch/tag/commit used for comparison."},
+				{Path: "breaking.against_module", Type: "string", Required: false, Description: "Module version used for comparison instead of git ref, e.g. github.com/acme/apis@v1.4.0."},
+				{Path: "breaking.against_input", Type: "string", Required: false, Description: "Directory, archive (.tar.gz, .tgz, .zip) or descriptor set (.binpb, .pb, .bin) used for comparison instead of git ref."},
 			},
 			Examples: []Example{
 				{
@@ -279,6 +331,18 @@ func docsByPath() map[string]nodeDoc {
 					YAML:        "breaking:\n  against_git_ref: origin/main\n  ignore:\n    - proto/experimental\n",
 					Paths:       []string{"breaking"},
 				},
+				{
+					Title:       "breaking_against_module",
+					Description: "Compare against the published module version.",
+					YAML:        "breaking:\n  against_module: github.com/acme/apis@v1.4.0\n",
+					Paths:       []string{"breaking"},
+				},
+				{
+					Title:       "breaking_wire_json",
+					Description: "Check only wire and JSON compatibility and skip unstable packages.",
+					YAML:        "breaking:\n  use:\n    - WIRE_JSON\n  except:\n    - FIELD_SAME_JSON_NAME\n  ignore_only:\n    FIELD_NO_DELETE_UNLESS_NAME_RESERVED:\n      - proto/internal\n  ignore_unstable_packages: true\n",
+					Paths:       []string{"breaking"},
+				},
 			},
 		},
 	}
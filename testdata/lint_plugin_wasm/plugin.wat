@@ -0,0 +1,10 @@
+(module
+  ;; lint plugin writes the fixed LintResponse to stdout, request from stdin is ignored
+  (import "wasi_snapshot_preview1" "fd_write" (func $fd_write (param i32 i32 i32 i32) (result i32)))
+  (memory (export "memory") 1)
+  (data (i32.const 16) "{\"issues\":[{\"path\":\"acme/v1/user.proto\",\"element\":\"acme.v1.User\",\"rule\":\"ACME_WASM\",\"message\":\"reported by wasm plugin\"}]}")
+  (func (export "_start")
+    ;; iovec: pointer and length of the response
+    (i32.store (i32.const 0) (i32.const 16))
+    (i32.store (i32.const 4) (i32.const 122))
+    (drop (call $fd_write (i32.const 1) (i32.const 0) (i32.const 1) (i32.const 8)))))